@@ -0,0 +1,86 @@
+package uuidv8
+
+import "fmt"
+
+// base58Alphabet is the Bitcoin base58 alphabet, which omits the easily confused characters 0, O, I and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Index maps an ASCII character to its value in base58Alphabet, or -1 if it is not part of it.
+var base58Index = func() [256]int8 {
+	var index [256]int8
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		index[base58Alphabet[i]] = int8(i)
+	}
+	return index
+}()
+
+// Helper function to encode a byte slice as a base58 string.
+func encodeBase58(src []byte) string {
+	// Leading zero bytes are encoded as leading '1' characters
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+
+	// Repeatedly multiply the accumulated base58 digits (little-endian) by 256 and add the next byte
+	digits := make([]byte, 0, len(src)*138/100+1)
+	for _, b := range src[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+
+	result := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		result[i] = base58Alphabet[0]
+	}
+	for i, d := range digits {
+		result[len(result)-1-i] = base58Alphabet[d]
+	}
+	return string(result)
+}
+
+// Helper function to decode a base58 string into a byte slice.
+func decodeBase58(s string) ([]byte, error) {
+	// Leading '1' characters are decoded as leading zero bytes
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	// Repeatedly multiply the accumulated bytes (little-endian) by 58 and add the next digit
+	decoded := make([]byte, 0, len(s))
+	for i := zeros; i < len(s); i++ {
+		digit := base58Index[s[i]]
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q at position %d", s[i], i)
+		}
+
+		carry := int(digit)
+		for j := range decoded {
+			carry += int(decoded[j]) * 58
+			decoded[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			decoded = append(decoded, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	result := make([]byte, zeros+len(decoded))
+	for i, b := range decoded {
+		result[len(result)-1-i] = b
+	}
+	return result, nil
+}
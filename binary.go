@@ -0,0 +1,101 @@
+package uuidv8
+
+import "fmt"
+
+// AppendBinaryTo writes the 16-byte binary representation of the UUIDv8 into buf without allocating.
+//
+// The layout matches BinaryCodec. A nil receiver writes an all-zero UUID.
+//
+// Parameters:
+// - buf: The fixed-size buffer to write into, e.g. a slot in a network packet.
+func (u *UUIDv8) AppendBinaryTo(buf *[16]byte) {
+	clear(buf[:])
+	if u == nil {
+		return
+	}
+	encodeUUIDv8(buf[:], u)
+}
+
+// AppendBytes appends the 16-byte binary representation of the UUIDv8 to dst.
+//
+// The layout matches AppendBinaryTo; no allocation happens if dst has room for 16 more bytes.
+// A nil UUIDv8 appends nothing.
+//
+// Parameters:
+// - dst: The buffer to append to. May be nil.
+//
+// Returns:
+// - The extended buffer.
+func (u *UUIDv8) AppendBytes(dst []byte) []byte {
+	if u == nil {
+		return dst
+	}
+
+	var buf [16]byte
+	u.AppendBinaryTo(&buf)
+	return append(dst, buf[:]...)
+}
+
+// FromBinaryArray parses the 16-byte binary representation of a UUIDv8.
+//
+// This is the counterpart of AppendBinaryTo.
+//
+// Parameters:
+// - buf: The 16 raw UUID bytes.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if the bytes are all zero or do not carry the UUIDv8 version and variant bits.
+func FromBinaryArray(buf [16]byte) (*UUIDv8, error) {
+	if err := validateUUIDv8Bytes(buf[:], variantRFC4122); err != nil {
+		return nil, fmt.Errorf("failed to parse binary UUID: %w", err)
+	}
+	return decodeUUIDv8(buf[:]), nil
+}
+
+// ToByteArray returns the 16-byte binary representation of a UUIDv8 as a value.
+//
+// Returning an array rather than a slice avoids a heap allocation, which suits hot paths that
+// write UUIDs to gRPC payloads, Kafka message keys or cache keys. The layout matches BinaryCodec.
+//
+// Parameters:
+// - u: A pointer to a UUIDv8 struct. A nil pointer yields an all-zero array.
+//
+// Returns:
+// - The 16 raw UUID bytes.
+func ToByteArray(u *UUIDv8) [16]byte {
+	var buf [16]byte
+	u.AppendBinaryTo(&buf)
+	return buf
+}
+
+// FromByteArray parses the 16-byte binary representation of a UUIDv8.
+//
+// This is the counterpart of ToByteArray, and behaves like FromBinaryArray: the version and
+// variant bits are validated like IsValidUUIDv8 does for strings.
+//
+// Parameters:
+// - b: The 16 raw UUID bytes, typically produced by ToByteArray.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if the bytes are all zero or do not carry the UUIDv8 version and variant bits.
+func FromByteArray(b [16]byte) (*UUIDv8, error) {
+	return FromBinaryArray(b)
+}
+
+// FromByteArrayOrNil parses the 16-byte binary representation of a UUIDv8, returning nil if invalid.
+//
+// Parameters:
+// - b: The 16 raw UUID bytes.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct if the bytes form a valid UUIDv8.
+// - Nil if they are all zero or do not carry the UUIDv8 version and variant bits.
+func FromByteArrayOrNil(b [16]byte) *UUIDv8 {
+	u, err := FromByteArray(b)
+	if err != nil {
+		return nil
+	}
+	return u
+}
@@ -0,0 +1,239 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestAppendBinaryTo(t *testing.T) {
+	uuid, err := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	var buf [16]byte
+	uuid.AppendBinaryTo(&buf)
+
+	expected, _ := uuidv8.BinaryCodec{}.Encode(uuid)
+	if !bytes.Equal(buf[:], expected) {
+		t.Errorf("Expected %x, got %x", expected, buf)
+	}
+
+	parsed, err := uuidv8.FromBinaryArray(buf)
+	if err != nil {
+		t.Fatalf("FromBinaryArray failed: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, uuid) {
+		t.Errorf("Round-trip mismatch: expected %+v, got %+v", uuid, parsed)
+	}
+}
+
+func TestAppendBinaryTo_OverwritesBuffer(t *testing.T) {
+	buf := [16]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+
+	uuid := &uuidv8.UUIDv8{Timestamp: 1, Node: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}}
+	uuid.AppendBinaryTo(&buf)
+	if result := uuidv8.ToString(uuid); result != "00000000-0001-8080-0102-030405060000" {
+		t.Fatalf("Unexpected UUID string: %s", result)
+	}
+
+	expected := [16]byte{0, 0, 0, 0, 0, 0x01, 0x80, 0x80, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0, 0}
+	if buf != expected {
+		t.Errorf("Expected %x, got %x", expected, buf)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	nilUUID.AppendBinaryTo(&buf)
+	if buf != ([16]byte{}) {
+		t.Errorf("Expected all-zero buffer for nil UUIDv8, got %x", buf)
+	}
+}
+
+func TestAppendBinaryTo_ZeroAllocations(t *testing.T) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	var buf [16]byte
+
+	allocs := testing.AllocsPerRun(100, func() {
+		uuid.AppendBinaryTo(&buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected zero allocations, got %v", allocs)
+	}
+}
+
+func TestFromBinaryArray_InvalidInputs(t *testing.T) {
+	invalid := []struct {
+		buf         [16]byte
+		description string
+	}{
+		{[16]byte{}, "All-zero UUID"},
+		{[16]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x70, 0x80}, "Incorrect version"},
+		{[16]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x80, 0xC0}, "Incorrect variant"},
+	}
+
+	for _, test := range invalid {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.FromBinaryArray(test.buf); err == nil {
+				t.Errorf("Expected error for %x", test.buf)
+			}
+		})
+	}
+}
+
+func TestToByteArray(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+	uuid, err := uuidv8.FromString(uuidStr)
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	arr := uuidv8.ToByteArray(uuid)
+	expected := [16]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x80, 0x80, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0, 0}
+	if arr != expected {
+		t.Errorf("Expected %x, got %x", expected, arr)
+	}
+
+	parsed, err := uuidv8.FromByteArray(arr)
+	if err != nil {
+		t.Fatalf("FromByteArray failed: %v", err)
+	}
+	if result := uuidv8.ToString(parsed); result != uuidStr {
+		t.Errorf("Round-trip mismatch: expected %s, got %s", uuidStr, result)
+	}
+
+	if parsed := uuidv8.FromByteArrayOrNil(arr); parsed == nil || uuidv8.ToString(parsed) != uuidStr {
+		t.Errorf("FromByteArrayOrNil failed to parse %x", arr)
+	}
+
+	if arr := uuidv8.ToByteArray(nil); arr != ([16]byte{}) {
+		t.Errorf("Expected all-zero array for nil UUIDv8, got %x", arr)
+	}
+}
+
+func TestFromByteArray_InvalidInputs(t *testing.T) {
+	invalid := []struct {
+		buf         [16]byte
+		description string
+	}{
+		{[16]byte{}, "All-zero UUID"},
+		{[16]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x40, 0x80}, "Incorrect version"},
+		{[16]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x80, 0x00}, "Incorrect variant"},
+	}
+
+	for _, test := range invalid {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.FromByteArray(test.buf); err == nil {
+				t.Errorf("Expected error for %x", test.buf)
+			}
+			if u := uuidv8.FromByteArrayOrNil(test.buf); u != nil {
+				t.Errorf("Expected nil for %x, got %+v", test.buf, u)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendBinaryTo(b *testing.B) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+
+	b.Run("AppendBinaryTo", func(b *testing.B) {
+		var buf [16]byte
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			uuid.AppendBinaryTo(&buf)
+		}
+	})
+	b.Run("BinaryCodec", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = uuidv8.BinaryCodec{}.Encode(uuid)
+		}
+	})
+}
+
+func BenchmarkMarshalBinary(b *testing.B) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+
+	b.Run("MarshalBinary", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = uuid.MarshalBinary()
+		}
+	})
+	b.Run("MarshalText", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = uuid.MarshalText()
+		}
+	})
+}
+
+func TestAppendBytes(t *testing.T) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	expected := uuidv8.ToByteArray(uuid)
+
+	if got := uuid.AppendBytes(nil); !bytes.Equal(got, expected[:]) {
+		t.Errorf("Expected %x, got %x", expected, got)
+	}
+
+	prefix := []byte("key:")
+	got := uuid.AppendBytes(prefix)
+	if !bytes.Equal(got, append([]byte("key:"), expected[:]...)) {
+		t.Errorf("Expected prefix to be preserved, got %x", got)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if got := nilUUID.AppendBytes(prefix); !bytes.Equal(got, prefix) {
+		t.Errorf("Expected nil UUIDv8 to append nothing, got %x", got)
+	}
+}
+
+func TestAppendFormatted(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+	uuid, _ := uuidv8.FromString(uuidStr)
+
+	if got := uuid.AppendFormatted(nil); string(got) != uuidStr {
+		t.Errorf("Expected %s, got %s", uuidStr, got)
+	}
+	if got := uuid.AppendFormatted([]byte("id=")); string(got) != "id="+uuidStr {
+		t.Errorf("Expected prefix to be preserved, got %s", got)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if got := nilUUID.AppendFormatted([]byte("id=")); string(got) != "id=" {
+		t.Errorf("Expected nil UUIDv8 to append nothing, got %s", got)
+	}
+}
+
+func TestAppend_ZeroAllocations(t *testing.T) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = uuid.AppendBytes(buf[:0])
+		buf = uuid.AppendFormatted(buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected zero allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkAppendFormatted(b *testing.B) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+
+	b.Run("AppendFormatted", func(b *testing.B) {
+		buf := make([]byte, 0, 36)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf = uuid.AppendFormatted(buf[:0])
+		}
+	})
+	b.Run("ToString", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = uuidv8.ToString(uuid)
+		}
+	})
+}
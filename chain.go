@@ -0,0 +1,92 @@
+package uuidv8
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transform post-processes a generated UUID string, e.g. to reformat, mask or encrypt it.
+type Transform func(string) (string, error)
+
+// ChainGenerator generates UUIDv8s with a Generator and passes them through a chain of Transforms.
+type ChainGenerator struct {
+	base       *Generator
+	transforms []Transform
+}
+
+// NewChainGenerator creates a ChainGenerator that applies the transforms, in order, to every UUID
+// generated by base.
+//
+// Parameters:
+// - base: The Generator producing the UUIDs. If nil, a new Generator is used.
+// - transforms: The transforms applied to each UUID, in order.
+//
+// Returns:
+// - A pointer to the ChainGenerator.
+func NewChainGenerator(base *Generator, transforms ...Transform) *ChainGenerator {
+	if base == nil {
+		base = NewGenerator()
+	}
+	return &ChainGenerator{base: base, transforms: append([]Transform(nil), transforms...)}
+}
+
+// New generates a UUIDv8 with the base Generator and applies the transforms to it.
+//
+// Returns:
+// - The transformed UUID string.
+// - An error if the base Generator fails or a transform returns an error; later transforms are not applied.
+func (g *ChainGenerator) New() (string, error) {
+	uuid, err := g.base.New()
+	if err != nil {
+		return "", err
+	}
+
+	for i, transform := range g.transforms {
+		uuid, err = transform(uuid)
+		if err != nil {
+			return "", fmt.Errorf("transform %d failed: %w", i, err)
+		}
+	}
+	return uuid, nil
+}
+
+// Close closes the base Generator.
+//
+// Returns:
+// - The error of the base Generator's Close.
+func (g *ChainGenerator) Close() error {
+	return g.base.Close()
+}
+
+// TransformUppercase is a Transform that uppercases the hex digits of the UUID.
+func TransformUppercase(uuid string) (string, error) {
+	return strings.ToUpper(uuid), nil
+}
+
+// TransformCompact is a Transform that converts the UUID to 32 hex characters without dashes.
+//
+// The input may be in any form accepted by FromString.
+func TransformCompact(uuid string) (string, error) {
+	parsed, err := FromString(uuid)
+	if err != nil {
+		return "", err
+	}
+	return marshalCompact(parsed)
+}
+
+// TransformRedactNode is a Transform that zeroes the node of the UUID, hiding the generating host.
+//
+// The input may be in any form accepted by FromString; the output is always the canonical
+// lowercase form, so apply it before formatting transforms.
+func TransformRedactNode(uuid string) (string, error) {
+	parsed, err := FromString(uuid)
+	if err != nil {
+		return "", err
+	}
+
+	redacted, err := parsed.WithNode(make([]byte, 6))
+	if err != nil {
+		return "", err
+	}
+	return ToString(redacted), nil
+}
@@ -0,0 +1,65 @@
+package uuidv8_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestChainGenerator_Transforms(t *testing.T) {
+	tests := []struct {
+		name       string
+		transforms []uuidv8.Transform
+		check      func(string) bool
+	}{
+		{"No transforms", nil, func(s string) bool { return len(s) == 36 }},
+		{"Uppercase", []uuidv8.Transform{uuidv8.TransformUppercase}, func(s string) bool { return s == strings.ToUpper(s) && len(s) == 36 }},
+		{"Compact", []uuidv8.Transform{uuidv8.TransformCompact}, func(s string) bool { return len(s) == 32 && !strings.Contains(s, "-") }},
+		{"Redact node", []uuidv8.Transform{uuidv8.TransformRedactNode}, func(s string) bool { return s[19:23] == "0000" && s[24:32] == "00000000" }},
+		{"Redact then compact and uppercase", []uuidv8.Transform{uuidv8.TransformRedactNode, uuidv8.TransformCompact, uuidv8.TransformUppercase}, func(s string) bool {
+			return len(s) == 32 && s[16:28] == "000000000000" && s == strings.ToUpper(s)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := uuidv8.NewChainGenerator(uuidv8.NewGenerator(), test.transforms...)
+			uuid, err := g.New()
+			if err != nil {
+				t.Fatalf("ChainGenerator.New failed: %v", err)
+			}
+			if !test.check(uuid) {
+				t.Errorf("Unexpected transformed UUID: %s", uuid)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("Transformed UUID is no longer a valid UUIDv8: %s", uuid)
+			}
+		})
+	}
+}
+
+func TestChainGenerator_TransformError(t *testing.T) {
+	errMask := errors.New("mask unavailable")
+	called := false
+
+	g := uuidv8.NewChainGenerator(nil,
+		func(string) (string, error) { return "", errMask },
+		func(s string) (string, error) { called = true; return s, nil },
+	)
+
+	if _, err := g.New(); !errors.Is(err, errMask) {
+		t.Errorf("Expected the transform error to propagate, got %v", err)
+	}
+	if called {
+		t.Errorf("Expected the chain to stop at the failing transform")
+	}
+}
+
+func TestChainGenerator_Close(t *testing.T) {
+	g := uuidv8.NewChainGenerator(nil, uuidv8.TransformUppercase)
+	if err := g.Close(); err != nil {
+		t.Errorf("ChainGenerator.Close returned an error: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package uuidv8
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sync"
+)
+
+var (
+	networkPrefixesMu sync.RWMutex
+	networkPrefixes   = map[[6]byte]string{}
+)
+
+// NewWithCIDR generates a UUIDv8 whose node identifies the network of a CIDR block.
+//
+// The node is the low 6 bytes of the FNV-1a 64-bit hash of the network address and prefix
+// length, so all services in the same subnet generate UUIDs with the same node. Host bits are
+// ignored ("10.0.1.7/24" and "10.0.1.0/24" share a node). The timestamp is the current time in
+// nanoseconds and the clock sequence is random.
+//
+// Parameters:
+// - cidr: An IPv4 or IPv6 CIDR block, e.g. "10.0.1.0/24" or "2001:db8::/32".
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the CIDR cannot be parsed or the random clock sequence cannot be generated.
+func NewWithCIDR(cidr string) (string, error) {
+	network, err := parseNetwork(cidr)
+	if err != nil {
+		return "", err
+	}
+
+	node := cidrNode(network)
+	return newWithNode(node[:])
+}
+
+// RegisterNetwork makes a CIDR block known to ExtractNetworkPrefix.
+//
+// Register each subnet of interest once, e.g. at startup; registering the same subnet again is a
+// no-op. The registry lives in the current process only: UUIDs generated elsewhere can be mapped
+// back only if their subnet is registered here as well.
+//
+// Parameters:
+// - cidr: An IPv4 or IPv6 CIDR block, e.g. "10.0.1.0/24" or "2001:db8::/32".
+//
+// Returns:
+// - An error if the CIDR cannot be parsed.
+func RegisterNetwork(cidr string) error {
+	network, err := parseNetwork(cidr)
+	if err != nil {
+		return err
+	}
+
+	networkPrefixesMu.Lock()
+	defer networkPrefixesMu.Unlock()
+
+	networkPrefixes[cidrNode(network)] = network.String()
+	return nil
+}
+
+// ExtractNetworkPrefix returns the CIDR block of a UUIDv8 generated by NewWithCIDR.
+//
+// The node only holds a hash of the subnet, so the lookup is limited to subnets registered with
+// RegisterNetwork in the current process.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The CIDR block in canonical form, e.g. "10.0.1.0/24".
+// - An error if the input is not a valid UUIDv8 or its node matches no registered subnet.
+func ExtractNetworkPrefix(uuid string) (string, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract network prefix: %w", err)
+	}
+
+	networkPrefixesMu.RLock()
+	cidr, ok := networkPrefixes[[6]byte(node)]
+	networkPrefixesMu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("node %x does not match a registered network prefix", node)
+	}
+	return cidr, nil
+}
+
+// Helper function to remove a network registered with RegisterNetwork, used to keep tests independent.
+func unregisterNetwork(cidr string) {
+	network, err := parseNetwork(cidr)
+	if err != nil {
+		return
+	}
+
+	networkPrefixesMu.Lock()
+	defer networkPrefixesMu.Unlock()
+
+	delete(networkPrefixes, cidrNode(network))
+}
+
+// Helper function to parse a CIDR block into its network.
+func parseNetwork(cidr string) (*net.IPNet, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR: %w", err)
+	}
+	return network, nil
+}
+
+// Helper function to build the 6-byte node for a network.
+func cidrNode(network *net.IPNet) [6]byte {
+	ones, _ := network.Mask.Size()
+
+	h := fnv.New64a()
+	h.Write(network.IP)
+	h.Write([]byte{byte(ones)})
+
+	var sum [8]byte
+	binary.BigEndian.PutUint64(sum[:], h.Sum64())
+	return [6]byte(sum[2:])
+}
@@ -0,0 +1,117 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithCIDR(t *testing.T) {
+	tests := []struct {
+		cidr        string
+		expected    string
+		description string
+	}{
+		{"10.0.1.0/24", "10.0.1.0/24", "IPv4 network"},
+		{"10.0.1.7/24", "10.0.1.0/24", "IPv4 host bits are ignored"},
+		{"192.168.0.0/16", "192.168.0.0/16", "IPv4 shorter prefix"},
+		{"2001:db8::/32", "2001:db8::/32", "IPv6 network"},
+		{"2001:db8:0:1::5/64", "2001:db8:0:1::/64", "IPv6 host bits are ignored"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if err := uuidv8.RegisterNetwork(test.cidr); err != nil {
+				t.Fatalf("RegisterNetwork failed: %v", err)
+			}
+			t.Cleanup(func() { uuidv8.UnregisterNetwork(test.cidr) })
+
+			uuid, err := uuidv8.NewWithCIDR(test.cidr)
+			if err != nil {
+				t.Fatalf("NewWithCIDR failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithCIDR generated an invalid UUIDv8: %s", uuid)
+			}
+
+			cidr, err := uuidv8.ExtractNetworkPrefix(uuid)
+			if err != nil {
+				t.Fatalf("ExtractNetworkPrefix failed: %v", err)
+			}
+			if cidr != test.expected {
+				t.Errorf("Network prefix mismatch: expected %s, got %s", test.expected, cidr)
+			}
+		})
+	}
+}
+
+func TestNewWithCIDR_SharedNode(t *testing.T) {
+	first, err := uuidv8.NewWithCIDR("10.0.2.0/24")
+	if err != nil {
+		t.Fatalf("NewWithCIDR failed: %v", err)
+	}
+	second, err := uuidv8.NewWithCIDR("10.0.2.99/24")
+	if err != nil {
+		t.Fatalf("NewWithCIDR failed: %v", err)
+	}
+	other, err := uuidv8.NewWithCIDR("10.0.2.0/25")
+	if err != nil {
+		t.Fatalf("NewWithCIDR failed: %v", err)
+	}
+
+	if first[19:32] != second[19:32] {
+		t.Errorf("UUIDs in the same subnet should share a node: %s, %s", first, second)
+	}
+	if first[19:32] == other[19:32] {
+		t.Errorf("UUIDs with different prefix lengths should not share a node: %s, %s", first, other)
+	}
+}
+
+func TestNewWithCIDR_InvalidInputs(t *testing.T) {
+	for _, cidr := range []string{"", "10.0.1.0", "10.0.1.0/33", "not-a-cidr/24"} {
+		if _, err := uuidv8.NewWithCIDR(cidr); err == nil {
+			t.Errorf("Expected error for CIDR %q", cidr)
+		}
+	}
+
+	for _, cidr := range []string{"", "10.0.1.0", "not-a-cidr/24"} {
+		if err := uuidv8.RegisterNetwork(cidr); err == nil {
+			t.Errorf("RegisterNetwork: expected error for CIDR %q", cidr)
+		}
+	}
+
+	if _, err := uuidv8.ExtractNetworkPrefix("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+
+	unknown, err := uuidv8.NewWithParams(1, 0, []byte{0xde, 0xad, 0xbe, 0xef, 0x00, 0x01}, uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("NewWithParams failed: %v", err)
+	}
+	if _, err := uuidv8.ExtractNetworkPrefix(unknown); err == nil {
+		t.Error("Expected error for unknown network prefix")
+	}
+}
+
+func TestExtractNetworkPrefix_Unregistered(t *testing.T) {
+	uuid, err := uuidv8.NewWithCIDR("172.31.255.0/24")
+	if err != nil {
+		t.Fatalf("NewWithCIDR failed: %v", err)
+	}
+
+	// Generating a UUID does not register its subnet
+	if _, err := uuidv8.ExtractNetworkPrefix(uuid); err == nil {
+		t.Error("Expected error for an unregistered network prefix")
+	}
+
+	if err := uuidv8.RegisterNetwork("172.31.255.0/24"); err != nil {
+		t.Fatalf("RegisterNetwork failed: %v", err)
+	}
+	t.Cleanup(func() { uuidv8.UnregisterNetwork("172.31.255.0/24") })
+	if err := uuidv8.RegisterNetwork("172.31.255.1/24"); err != nil {
+		t.Fatalf("Registering the same subnet again failed: %v", err)
+	}
+	if cidr, err := uuidv8.ExtractNetworkPrefix(uuid); err != nil || cidr != "172.31.255.0/24" {
+		t.Errorf("Expected 172.31.255.0/24 after registration, got %q (err=%v)", cidr, err)
+	}
+}
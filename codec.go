@@ -0,0 +1,136 @@
+package uuidv8
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Codec encodes and decodes UUIDv8 structs, allowing the serialization strategy to be injected.
+type Codec interface {
+	Encode(*UUIDv8) ([]byte, error)
+	Decode([]byte) (*UUIDv8, error)
+}
+
+// Built-in codecs.
+type (
+	StringCodec  struct{} // Canonical 36-character dashed form
+	BinaryCodec  struct{} // 16 raw bytes
+	Base64Codec  struct{} // 22 characters of unpadded URL-safe base64
+	CompactCodec struct{} // 32 hex characters without dashes
+)
+
+// Encode implements the Codec interface.
+func (StringCodec) Encode(u *UUIDv8) ([]byte, error) {
+	return encodeWith(u, marshalUUIDString)
+}
+
+// Decode implements the Codec interface.
+func (StringCodec) Decode(data []byte) (*UUIDv8, error) {
+	if len(data) != 36 {
+		return nil, fmt.Errorf("UUID string must be 36 characters, got %d", len(data))
+	}
+	return FromString(string(data))
+}
+
+// Encode implements the Codec interface.
+func (BinaryCodec) Encode(u *UUIDv8) ([]byte, error) {
+	if u == nil {
+		return nil, errors.New("cannot encode a nil UUIDv8")
+	}
+	return uuidv8Bytes(u), nil
+}
+
+// Decode implements the Codec interface.
+func (BinaryCodec) Decode(data []byte) (*UUIDv8, error) {
+	// Copy so the returned node does not alias the caller's buffer
+	return uuidv8FromBytes(append([]byte(nil), data...))
+}
+
+// Encode implements the Codec interface.
+func (Base64Codec) Encode(u *UUIDv8) ([]byte, error) {
+	return encodeWith(u, marshalBase64)
+}
+
+// Decode implements the Codec interface.
+func (Base64Codec) Decode(data []byte) (*UUIDv8, error) {
+	return unmarshalBase64(string(data))
+}
+
+// Encode implements the Codec interface.
+func (CompactCodec) Encode(u *UUIDv8) ([]byte, error) {
+	return encodeWith(u, marshalCompact)
+}
+
+// Decode implements the Codec interface.
+func (CompactCodec) Decode(data []byte) (*UUIDv8, error) {
+	return unmarshalCompact(string(data))
+}
+
+// CodecGenerator generates UUIDv8s with a Generator and encodes and parses them using an injected Codec.
+type CodecGenerator struct {
+	codec     Codec
+	generator *Generator
+}
+
+// NewWithCodec creates a CodecGenerator that encodes and decodes UUIDv8s with the given Codec.
+//
+// Parameters:
+// - c: The codec used by Generate and Parse.
+// - g: The Generator producing the UUIDs. If nil, a new Generator is used.
+//
+// Returns:
+// - A pointer to the CodecGenerator.
+func NewWithCodec(c Codec, g *Generator) *CodecGenerator {
+	if g == nil {
+		g = NewGenerator()
+	}
+	return &CodecGenerator{codec: c, generator: g}
+}
+
+// Generate creates a new UUIDv8 with the wrapped Generator and encodes it with the codec.
+//
+// Returns:
+// - The encoded UUIDv8.
+// - An error if generation or encoding fails.
+func (g *CodecGenerator) Generate() ([]byte, error) {
+	uuid, err := g.generator.New()
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := FromString(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return g.codec.Encode(parsed)
+}
+
+// Parse decodes data with the generator's codec.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the decoded components.
+// - An error if decoding fails.
+func (g *CodecGenerator) Parse(data []byte) (*UUIDv8, error) {
+	return g.codec.Decode(data)
+}
+
+// Close closes the wrapped Generator.
+//
+// Returns:
+// - The error of the wrapped Generator's Close.
+func (g *CodecGenerator) Close() error {
+	return g.generator.Close()
+}
+
+// Helper function to encode a UUIDv8 struct with a string marshal function.
+func encodeWith(u *UUIDv8, marshal func(*UUIDv8) (string, error)) ([]byte, error) {
+	if u == nil {
+		return nil, errors.New("cannot encode a nil UUIDv8")
+	}
+
+	s, err := marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
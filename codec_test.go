@@ -0,0 +1,104 @@
+package uuidv8_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestCodecs_RoundTrip(t *testing.T) {
+	uuid, err := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	codecs := []struct {
+		codec       uuidv8.Codec
+		expected    string
+		description string
+	}{
+		{uuidv8.StringCodec{}, "9a3d4049-0e2c-8080-0102-030405060000", "String codec"},
+		{uuidv8.BinaryCodec{}, "\x9a\x3d\x40\x49\x0e\x2c\x80\x80\x01\x02\x03\x04\x05\x06\x00\x00", "Binary codec"},
+		{uuidv8.Base64Codec{}, "mj1ASQ4sgIABAgMEBQYAAA", "Base64 codec"},
+		{uuidv8.CompactCodec{}, "9a3d40490e2c80800102030405060000", "Compact codec"},
+	}
+
+	for _, test := range codecs {
+		t.Run(test.description, func(t *testing.T) {
+			encoded, err := test.codec.Encode(uuid)
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			if string(encoded) != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, encoded)
+			}
+
+			decoded, err := test.codec.Decode(encoded)
+			if err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, uuid) {
+				t.Errorf("Round-trip mismatch: expected %+v, got %+v", uuid, decoded)
+			}
+
+			if _, err := test.codec.Encode(nil); err == nil {
+				t.Error("Expected error when encoding a nil UUIDv8")
+			}
+			if _, err := test.codec.Decode([]byte("invalid")); err == nil {
+				t.Error("Expected error when decoding invalid data")
+			}
+		})
+	}
+}
+
+func TestCodecGenerator(t *testing.T) {
+	codecs := []uuidv8.Codec{
+		uuidv8.StringCodec{},
+		uuidv8.BinaryCodec{},
+		uuidv8.Base64Codec{},
+		uuidv8.CompactCodec{},
+	}
+
+	for _, codec := range codecs {
+		t.Run(reflect.TypeOf(codec).Name(), func(t *testing.T) {
+			g := uuidv8.NewWithCodec(codec, nil)
+
+			data, err := g.Generate()
+			if err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			parsed, err := g.Parse(data)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuidv8.ToString(parsed)) {
+				t.Errorf("Generated UUID is not a valid UUIDv8: %s", uuidv8.ToString(parsed))
+			}
+		})
+	}
+}
+
+func TestCodecGenerator_WrapsGenerator(t *testing.T) {
+	g := uuidv8.NewWithCodec(uuidv8.StringCodec{}, uuidv8.NewGenerator())
+
+	var prev string
+	for i := 0; i < 100; i++ {
+		data, err := g.Generate()
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		if string(data) <= prev {
+			t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, data, prev)
+		}
+		prev = string(data)
+	}
+}
+
+func TestCodecGenerator_Close(t *testing.T) {
+	g := uuidv8.NewWithCodec(uuidv8.StringCodec{}, nil)
+	if err := g.Close(); err != nil {
+		t.Errorf("CodecGenerator.Close returned an error: %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package uuidv8
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// clockSeqRolloverWindow is the largest timestamp difference within which IsTemporallyOrdered assumes a clock sequence rollover.
+const clockSeqRolloverWindow = uint64(time.Millisecond)
+
+// IsTemporallyOrdered reports whether UUIDv8 a was generated before UUIDv8 b.
+//
+// a is ordered before b if its timestamp is lower, or if the timestamps are equal and its clock
+// sequence is lower. When b's clock sequence is lower than a's but the timestamps differ by less
+// than 1ms, the clock sequence is assumed to have rolled over and b is treated as coming after a.
+//
+// The heuristic has limitations:
+// - It assumes nanosecond timestamps as generated by New; other units shift the 1ms window.
+// - Within the window it cannot tell a rollover from b genuinely being older, so two UUIDs close in time may both be reported as ordered before each other.
+// - Clock sequences are only meaningful when they are assigned by a counter; random clock sequences (as generated by New) make the tie-break arbitrary.
+//
+// Parameters:
+// - a: A string representation of the UUIDv8 expected to come first.
+// - b: A string representation of the UUIDv8 expected to come second.
+//
+// Returns:
+// - A boolean indicating whether a is ordered before b.
+// - An error if either input is not a valid UUIDv8.
+func IsTemporallyOrdered(a, b string) (bool, error) {
+	aBytes, err := parseUUIDv8(a)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare UUIDs: %w", err)
+	}
+	bBytes, err := parseUUIDv8(b)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare UUIDs: %w", err)
+	}
+
+	ua, ub := decodeUUIDv8(aBytes), decodeUUIDv8(bBytes)
+	csA, csB := ua.ClockSeq&clockSeqMask, ub.ClockSeq&clockSeqMask
+
+	if csB < csA && absDiff(ua.Timestamp, ub.Timestamp) < clockSeqRolloverWindow {
+		return true, nil
+	}
+	if ua.Timestamp != ub.Timestamp {
+		return ua.Timestamp < ub.Timestamp, nil
+	}
+	return csA < csB, nil
+}
+
+// Compare orders two UUIDv8 structs chronologically.
+//
+// UUIDs are ordered by Timestamp, then by ClockSeq, then lexicographically by Node. A nil UUID
+// sorts before any non-nil UUID. The fields are compared as stored, so UUIDs generated with
+// different timestamp bit sizes are still ordered consistently (if not meaningfully).
+//
+// Parameters:
+// - a: A pointer to the first UUIDv8 struct.
+// - b: A pointer to the second UUIDv8 struct.
+//
+// Returns:
+// - -1 if a sorts before b, 0 if they are equal, and +1 if a sorts after b.
+func Compare(a, b *UUIDv8) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if c := cmp.Compare(a.Timestamp, b.Timestamp); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.ClockSeq, b.ClockSeq); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.Node, b.Node)
+}
+
+// CompareStrings orders two UUID strings chronologically, e.g. for slices.SortFunc.
+//
+// Both strings are parsed (any format accepted by FromString) and the bytes holding the timestamp,
+// clock sequence and node are compared directly, which matches Compare for the parsed structs
+// without materializing them. Strings that are not valid UUIDv8s (see Validate), including other
+// UUID versions and the nil UUID, sort before all valid UUIDv8s and are ordered among themselves
+// with strings.Compare.
+//
+// Parameters:
+// - a: The first UUID string.
+// - b: The second UUID string.
+//
+// Returns:
+// - -1 if a sorts before b, 0 if they are equal, and +1 if a sorts after b.
+func CompareStrings(a, b string) int {
+	aBytes, aErr := parseUUIDv8(a)
+	bBytes, bErr := parseUUIDv8(b)
+
+	switch {
+	case aErr != nil && bErr != nil:
+		return strings.Compare(a, b)
+	case aErr != nil:
+		return -1
+	case bErr != nil:
+		return 1
+	}
+	// Bytes 14 and 15 are not part of any field Compare looks at
+	return bytes.Compare(aBytes[:14], bBytes[:14])
+}
+
+// Helper function to compute the absolute difference of two timestamps.
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
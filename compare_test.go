@@ -0,0 +1,163 @@
+package uuidv8_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestIsTemporallyOrdered(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	const base = uint64(1 << 40)
+
+	mustNew := func(timestamp uint64, clockSeq uint16) string {
+		uuid, err := uuidv8.NewWithParams(timestamp, clockSeq, node, uuidv8.TimestampBits48)
+		if err != nil {
+			t.Fatalf("NewWithParams failed: %v", err)
+		}
+		return uuid
+	}
+
+	tests := []struct {
+		a, b        string
+		expected    bool
+		description string
+	}{
+		{mustNew(base, 0x001), mustNew(base+5_000_000, 0x001), true, "Earlier timestamp"},
+		{mustNew(base+5_000_000, 0x001), mustNew(base, 0x001), false, "Later timestamp"},
+		{mustNew(base, 0x001), mustNew(base, 0x002), true, "Same timestamp, lower clock sequence"},
+		{mustNew(base, 0x002), mustNew(base, 0x002), false, "Identical components"},
+		{mustNew(base, 0xF3F), mustNew(base, 0x000), true, "Same timestamp, clock sequence rolled over"},
+		{mustNew(base+500_000, 0xF3F), mustNew(base, 0x000), true, "Rollover within 1ms"},
+		{mustNew(base+5_000_000, 0xF3F), mustNew(base, 0x000), false, "Lower clock sequence outside of 1ms"},
+		{mustNew(base, 0x000), mustNew(base+5_000_000, 0xF3F), true, "Earlier timestamp, higher clock sequence"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ordered, err := uuidv8.IsTemporallyOrdered(test.a, test.b)
+			if err != nil {
+				t.Fatalf("IsTemporallyOrdered failed: %v", err)
+			}
+			if ordered != test.expected {
+				t.Errorf("IsTemporallyOrdered(%s, %s) = %v, expected %v", test.a, test.b, ordered, test.expected)
+			}
+		})
+	}
+}
+
+func TestIsTemporallyOrdered_InvalidUUID(t *testing.T) {
+	valid, _ := uuidv8.New()
+
+	if _, err := uuidv8.IsTemporallyOrdered("invalid-uuid", valid); err == nil {
+		t.Error("Expected error for invalid first UUID")
+	}
+	if _, err := uuidv8.IsTemporallyOrdered(valid, "invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid second UUID")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	otherNode := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x07}
+
+	tests := []struct {
+		a, b        *uuidv8.UUIDv8
+		expected    int
+		description string
+	}{
+		{&uuidv8.UUIDv8{Timestamp: 1, Node: node}, &uuidv8.UUIDv8{Timestamp: 2, Node: node}, -1, "Lower timestamp"},
+		{&uuidv8.UUIDv8{Timestamp: 2, Node: node}, &uuidv8.UUIDv8{Timestamp: 1, Node: node}, 1, "Higher timestamp"},
+		{&uuidv8.UUIDv8{Timestamp: 1, ClockSeq: 1, Node: node}, &uuidv8.UUIDv8{Timestamp: 1, ClockSeq: 2, Node: node}, -1, "Lower clock sequence"},
+		{&uuidv8.UUIDv8{Timestamp: 1, Node: otherNode}, &uuidv8.UUIDv8{Timestamp: 1, Node: node}, 1, "Higher node"},
+		{&uuidv8.UUIDv8{Timestamp: 1, ClockSeq: 3, Node: node}, &uuidv8.UUIDv8{Timestamp: 1, ClockSeq: 3, Node: node}, 0, "Equal"},
+		{&uuidv8.UUIDv8{Node: make([]byte, 6)}, &uuidv8.UUIDv8{Timestamp: 1, Node: node}, -1, "Zero UUID"},
+		{nil, &uuidv8.UUIDv8{Timestamp: 1, Node: node}, -1, "Nil first"},
+		{&uuidv8.UUIDv8{Timestamp: 1, Node: node}, nil, 1, "Nil second"},
+		{nil, nil, 0, "Both nil"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if got := uuidv8.Compare(test.a, test.b); got != test.expected {
+				t.Errorf("Compare(%+v, %+v) = %d, expected %d", test.a, test.b, got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCompareStrings(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	var uuids []string
+	for _, params := range []struct {
+		timestamp uint64
+		clockSeq  uint16
+		bits      int
+	}{
+		{3, 0x001, uuidv8.TimestampBits48},
+		{1, 0x002, uuidv8.TimestampBits48},
+		{1, 0x001, uuidv8.TimestampBits48},
+		{1, 0x001, uuidv8.TimestampBits32},
+		{2, 0x001, uuidv8.TimestampBits60},
+	} {
+		uuid, err := uuidv8.NewWithParams(params.timestamp, params.clockSeq, node, params.bits)
+		if err != nil {
+			t.Fatalf("NewWithParams failed: %v", err)
+		}
+		uuids = append(uuids, uuid)
+	}
+	uuids = append(uuids, "00000000-0000-0000-0000-000000000000", "invalid-uuid")
+
+	slices.SortFunc(uuids, uuidv8.CompareStrings)
+
+	// CompareStrings must agree with Compare on the parsed structs
+	for i := 1; i < len(uuids); i++ {
+		a, b := uuidv8.FromStringOrNil(uuids[i-1]), uuidv8.FromStringOrNil(uuids[i])
+		if b != nil && uuidv8.Compare(a, b) > 0 {
+			t.Errorf("CompareStrings and Compare disagree on %s and %s", uuids[i-1], uuids[i])
+		}
+	}
+
+	if uuids[0] != "00000000-0000-0000-0000-000000000000" || uuids[1] != "invalid-uuid" {
+		t.Errorf("Expected zero and invalid UUIDs to sort first, got %v", uuids[:2])
+	}
+
+	upper := strings.ToUpper(uuids[2])
+	if uuidv8.CompareStrings(uuids[2], upper) != 0 {
+		t.Errorf("Expected %s and %s to compare equal", uuids[2], upper)
+	}
+	if uuidv8.CompareStrings("a", "b") != -1 || uuidv8.CompareStrings("b", "a") != 1 {
+		t.Error("Expected invalid strings to be ordered with strings.Compare")
+	}
+}
+
+func TestCompareStrings_AgreesWithCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		// Differ only in bytes 14-15, which Compare ignores
+		{"9a3d4049-0e2c-8080-0102-030405060000", "9a3d4049-0e2c-8080-0102-030405060001", 0},
+		// Differ in the clock sequence
+		{"9a3d4049-0e2c-8081-0102-030405060000", "9a3d4049-0e2c-8080-0102-030405060000", 1},
+		// A UUIDv7 with a later timestamp still sorts before any valid UUIDv8
+		{"0193bde4-a9fa-77eb-a304-6cf8530ece78", "0000075b-cd15-8880-0102-030405060000", -1},
+	}
+
+	for _, test := range tests {
+		if result := uuidv8.CompareStrings(test.a, test.b); result != test.expected {
+			t.Errorf("CompareStrings(%s, %s): expected %d, got %d", test.a, test.b, test.expected, result)
+		}
+		if result := uuidv8.CompareStrings(test.b, test.a); result != -test.expected {
+			t.Errorf("CompareStrings(%s, %s): expected %d, got %d", test.b, test.a, -test.expected, result)
+		}
+
+		a, b := uuidv8.FromStringOrNil(test.a), uuidv8.FromStringOrNil(test.b)
+		if uuidv8.IsValidUUIDv8(test.a) && uuidv8.IsValidUUIDv8(test.b) && uuidv8.Compare(a, b) != test.expected {
+			t.Errorf("Compare(%s, %s): expected %d, got %d", test.a, test.b, test.expected, uuidv8.Compare(a, b))
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package uuidv8
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// maxWorkers is the number of workers that get distinct nodes from the 16-bit worker index.
+const maxWorkers = 1 << 16
+
+// ConcurrentGenerator spreads UUID generation over a pool of independent Generators.
+//
+// A single Generator serializes callers on its lock and is limited to 1024 UUIDs per millisecond.
+// ConcurrentGenerator dispatches New round-robin over its workers, each with its own lock, clock
+// sequence and node, so throughput scales with the number of workers. The UUIDs of one worker are
+// ordered, but UUIDs of different workers are not ordered relative to each other.
+//
+// A ConcurrentGenerator is safe for concurrent use.
+type ConcurrentGenerator struct {
+	workers []*Generator
+	next    atomic.Uint64
+}
+
+// NewConcurrentGenerator creates a ConcurrentGenerator with the given number of workers.
+//
+// All workers share a base node, random or from the WithNodeFunc option, whose last 2 bytes are
+// offset by the worker index, so workers never produce the same UUID.
+//
+// Parameters:
+// - workers: The number of Generators in the pool, between 1 and 65536.
+// - opts: Options applied to every worker.
+//
+// Returns:
+// - A pointer to the ConcurrentGenerator.
+// - An error if the number of workers is out of range or the random base node cannot be generated.
+func NewConcurrentGenerator(workers int, opts ...Option) (*ConcurrentGenerator, error) {
+	if workers < 1 || workers > maxWorkers {
+		return nil, fmt.Errorf("number of workers must be between 1 and %d, got %d", maxWorkers, workers)
+	}
+
+	base, err := randomNode()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ConcurrentGenerator{workers: make([]*Generator, workers)}
+	for i := range c.workers {
+		g := NewGenerator(opts...)
+		g.nodeFunc = workerNodeFunc(g.nodeFunc, base, uint16(i))
+		c.workers[i] = g
+	}
+	return c, nil
+}
+
+// New generates a UUIDv8 with the next worker of the pool.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the worker fails, see Generator.New.
+func (c *ConcurrentGenerator) New() (string, error) {
+	i := c.next.Add(1) - 1
+	return c.workers[i%uint64(len(c.workers))].New()
+}
+
+// Close closes every worker of the pool.
+//
+// Returns:
+// - The errors of all workers that failed to close, joined, or nil.
+func (c *ConcurrentGenerator) Close() error {
+	errs := make([]error, len(c.workers))
+	for i, g := range c.workers {
+		errs[i] = g.Close()
+	}
+	return errors.Join(errs...)
+}
+
+// Helper function to derive the node function of a worker, offsetting the base node by the worker index.
+func workerNodeFunc(nodeFunc func() ([]byte, error), base []byte, index uint16) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		node := base
+		if nodeFunc != nil {
+			var err error
+			if node, err = nodeFunc(); err != nil || len(node) != 6 {
+				// Generator.newNode reports the error or invalid length
+				return node, err
+			}
+		}
+
+		derived := append([]byte(nil), node...)
+		binary.BigEndian.PutUint16(derived[4:], binary.BigEndian.Uint16(node[4:])+index)
+		return derived, nil
+	}
+}
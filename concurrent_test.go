@@ -0,0 +1,114 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestConcurrentGenerator(t *testing.T) {
+	const workers = 4
+
+	g, err := uuidv8.NewConcurrentGenerator(workers)
+	if err != nil {
+		t.Fatalf("NewConcurrentGenerator failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[string]bool)
+	nodes := make(map[string]bool)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				uuid, err := g.New()
+				if err != nil {
+					t.Errorf("ConcurrentGenerator.New failed: %v", err)
+					return
+				}
+				mu.Lock()
+				if seen[uuid] {
+					t.Errorf("Duplicate UUID: %s", uuid)
+				}
+				seen[uuid] = true
+				nodes[uuid[19:36]] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(nodes) != workers {
+		t.Errorf("Expected %d distinct worker nodes, got %d", workers, len(nodes))
+	}
+}
+
+func TestConcurrentGenerator_WithNodeFunc(t *testing.T) {
+	base := []byte{0x01, 0x02, 0x03, 0x04, 0xff, 0xff}
+	g, err := uuidv8.NewConcurrentGenerator(2, uuidv8.WithNodeFunc(func() ([]byte, error) { return base, nil }))
+	if err != nil {
+		t.Fatalf("NewConcurrentGenerator failed: %v", err)
+	}
+
+	expected := [][]byte{
+		{0x01, 0x02, 0x03, 0x04, 0xff, 0xff},
+		{0x01, 0x02, 0x03, 0x04, 0x00, 0x00},
+	}
+	for i, node := range expected {
+		uuid, err := g.New()
+		if err != nil {
+			t.Fatalf("ConcurrentGenerator.New failed: %v", err)
+		}
+		if parsed := uuidv8.FromStringOrNil(uuid); parsed == nil || !bytes.Equal(parsed.Node, node) {
+			t.Errorf("Worker %d: expected node %x in %s", i, node, uuid)
+		}
+	}
+}
+
+func TestNewConcurrentGenerator_InvalidWorkers(t *testing.T) {
+	for _, workers := range []int{-1, 0, 1<<16 + 1} {
+		if _, err := uuidv8.NewConcurrentGenerator(workers); err == nil {
+			t.Errorf("Expected error for %d workers", workers)
+		}
+	}
+}
+
+func BenchmarkConcurrentGenerator(b *testing.B) {
+	b.Run("Generator", func(b *testing.B) {
+		g := uuidv8.NewGenerator()
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = g.New()
+			}
+		})
+	})
+	b.Run("ConcurrentGenerator", func(b *testing.B) {
+		g, err := uuidv8.NewConcurrentGenerator(runtime.GOMAXPROCS(0))
+		if err != nil {
+			b.Fatalf("NewConcurrentGenerator failed: %v", err)
+		}
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = g.New()
+			}
+		})
+	})
+}
+
+func TestConcurrentGenerator_Close(t *testing.T) {
+	g, err := uuidv8.NewConcurrentGenerator(4)
+	if err != nil {
+		t.Fatalf("NewConcurrentGenerator failed: %v", err)
+	}
+	if err := g.Close(); err != nil {
+		t.Errorf("ConcurrentGenerator.Close returned an error: %v", err)
+	}
+}
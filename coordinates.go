@@ -0,0 +1,71 @@
+package uuidv8
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// NewWithCoordinates generates a UUIDv8 whose node embeds quantized geographic coordinates.
+//
+// Latitude [-90, 90] and longitude [-180, 180] are each linearly quantized to an int16
+// [-32768, 32767] and stored big-endian in the first 4 node bytes; the last 2 node bytes are
+// random. This enables prefix-based spatial filtering without a separate geohash column. The
+// quantization step is 180/65535 ≈ 0.0027° (about 300 m) for latitude and 360/65535 ≈ 0.0055°
+// for longitude, so ExtractCoordinates returns values within half a step of the input.
+//
+// Parameters:
+// - lat: The latitude in degrees, in [-90, 90].
+// - lon: The longitude in degrees, in [-180, 180].
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if a coordinate is out of range or random data cannot be generated.
+func NewWithCoordinates(lat, lon float64) (string, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return "", fmt.Errorf("latitude must be between -90 and 90, got %v", lat)
+	}
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return "", fmt.Errorf("longitude must be between -180 and 180, got %v", lon)
+	}
+
+	node := make([]byte, 6)
+	binary.BigEndian.PutUint16(node[0:2], uint16(quantizeCoordinate(lat, 90)))
+	binary.BigEndian.PutUint16(node[2:4], uint16(quantizeCoordinate(lon, 180)))
+	if _, err := rand.Read(node[4:]); err != nil {
+		return "", fmt.Errorf("failed to generate random node: %w", err)
+	}
+
+	return newWithNode(node)
+}
+
+// ExtractCoordinates returns the coordinates of a UUIDv8 generated by NewWithCoordinates.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - lat: The dequantized latitude in degrees.
+// - lon: The dequantized longitude in degrees.
+// - err: An error if the input is not a valid UUIDv8.
+func ExtractCoordinates(uuid string) (lat, lon float64, err error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to extract coordinates: %w", err)
+	}
+
+	lat = dequantizeCoordinate(int16(binary.BigEndian.Uint16(node[0:2])), 90)
+	lon = dequantizeCoordinate(int16(binary.BigEndian.Uint16(node[2:4])), 180)
+	return lat, lon, nil
+}
+
+// Helper function to map a coordinate in [-limit, limit] onto [-32768, 32767].
+func quantizeCoordinate(value, limit float64) int16 {
+	return int16(math.Round((value+limit)/(2*limit)*math.MaxUint16) + math.MinInt16)
+}
+
+// Helper function to map a quantized coordinate in [-32768, 32767] back onto [-limit, limit].
+func dequantizeCoordinate(q int16, limit float64) float64 {
+	return (float64(q)-math.MinInt16)/math.MaxUint16*(2*limit) - limit
+}
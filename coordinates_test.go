@@ -0,0 +1,85 @@
+package uuidv8_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithCoordinates(t *testing.T) {
+	const (
+		latTolerance = 180.0 / 65535 / 2
+		lonTolerance = 360.0 / 65535 / 2
+	)
+
+	tests := []struct {
+		lat, lon    float64
+		description string
+	}{
+		{52.5200, 13.4050, "Berlin"},
+		{-33.8688, 151.2093, "Sydney"},
+		{0, 0, "Null island"},
+		{90, 180, "Maximum values"},
+		{-90, -180, "Minimum values"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithCoordinates(test.lat, test.lon)
+			if err != nil {
+				t.Fatalf("NewWithCoordinates failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithCoordinates generated an invalid UUIDv8: %s", uuid)
+			}
+
+			lat, lon, err := uuidv8.ExtractCoordinates(uuid)
+			if err != nil {
+				t.Fatalf("ExtractCoordinates failed: %v", err)
+			}
+			if math.Abs(lat-test.lat) > latTolerance {
+				t.Errorf("Latitude mismatch: expected %v, got %v", test.lat, lat)
+			}
+			if math.Abs(lon-test.lon) > lonTolerance {
+				t.Errorf("Longitude mismatch: expected %v, got %v", test.lon, lon)
+			}
+		})
+	}
+}
+
+func TestNewWithCoordinates_SharedPrefix(t *testing.T) {
+	first, _ := uuidv8.NewWithCoordinates(48.8566, 2.3522)
+	second, _ := uuidv8.NewWithCoordinates(48.8566, 2.3522)
+
+	// The coordinates occupy the first 4 node bytes: characters 19-22 and 24-27
+	if first[19:28] != second[19:28] {
+		t.Errorf("Expected UUIDs for the same location to share a node prefix: %s, %s", first, second)
+	}
+}
+
+func TestNewWithCoordinates_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		lat, lon    float64
+		description string
+	}{
+		{90.1, 0, "Latitude too large"},
+		{-90.1, 0, "Latitude too small"},
+		{0, 180.1, "Longitude too large"},
+		{0, -180.1, "Longitude too small"},
+		{math.NaN(), 0, "NaN latitude"},
+		{0, math.NaN(), "NaN longitude"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.NewWithCoordinates(test.lat, test.lon); err == nil {
+				t.Errorf("Expected error for coordinates (%v, %v)", test.lat, test.lon)
+			}
+		})
+	}
+
+	if _, _, err := uuidv8.ExtractCoordinates("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
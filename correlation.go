@@ -0,0 +1,77 @@
+package uuidv8
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// NewWithCorrelationID generates a child UUIDv8 tagged with an upstream correlation ID.
+//
+// The correlation ID is hashed with FNV-1a 32, folded to 16 bits by XORing its halves and
+// stored as the clock sequence, keeping only the 10 bits that survive encoding. All children of
+// the same correlation ID therefore share a clock sequence, which AreCorrelated checks without
+// any out-of-band storage. The timestamp is the current time in nanoseconds.
+//
+// The tag replaces the whole clock sequence, leaving no random bits: uniqueness depends only on
+// the node and the timestamp. Two calls with the same node and correlation ID within the same
+// clock tick (the system clock only advances every 15ms on Windows and in many VMs) return the
+// same UUID, so give every concurrent producer its own node.
+//
+// The tag is only 10 bits wide, so unrelated correlation IDs collide roughly once in 1024; treat
+// it as a hint for tracing, not as proof of the relationship.
+//
+// Parameters:
+// - correlationID: The correlation ID received from upstream.
+// - node: A 6-byte slice representing a unique identifier.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the correlation ID is empty or the node is invalid.
+func NewWithCorrelationID(correlationID string, node []byte) (string, error) {
+	if correlationID == "" {
+		return "", fmt.Errorf("correlation ID must not be empty")
+	}
+
+	return newWithCorrelationTag(uint64(time.Now().UnixNano()), correlationID, node)
+}
+
+// Helper function to generate a UUIDv8 carrying the tag of a correlation ID at the given timestamp.
+func newWithCorrelationTag(timestamp uint64, correlationID string, node []byte) (string, error) {
+	return NewWithParams(timestamp, correlationTag(correlationID), node, TimestampBits48)
+}
+
+// AreCorrelated reports whether child was generated by NewWithCorrelationID for correlationID after parent.
+//
+// The child must carry the clock sequence tag of correlationID and must not be older than the
+// parent. Both UUIDs must be valid UUIDv8s.
+//
+// Parameters:
+// - parent: A string representation of the parent UUIDv8.
+// - child: A string representation of the child UUIDv8.
+// - correlationID: The correlation ID the child is expected to carry.
+//
+// Returns:
+// - A boolean indicating whether the UUIDs are correlated.
+func AreCorrelated(parent, child string, correlationID string) bool {
+	parentBytes, err := parseUUIDv8(parent)
+	if err != nil {
+		return false
+	}
+	childBytes, err := parseUUIDv8(child)
+	if err != nil {
+		return false
+	}
+
+	p, c := decodeUUIDv8(parentBytes), decodeUUIDv8(childBytes)
+	return c.ClockSeq&clockSeqMask == correlationTag(correlationID) && p.Timestamp <= c.Timestamp
+}
+
+// Helper function to derive the clock sequence tag of a correlation ID.
+func correlationTag(correlationID string) uint16 {
+	h := fnv.New32a()
+	h.Write([]byte(correlationID))
+	sum := h.Sum32()
+
+	return (uint16(sum>>16) ^ uint16(sum)) & clockSeqMask
+}
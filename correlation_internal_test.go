@@ -0,0 +1,35 @@
+package uuidv8
+
+import "testing"
+
+func TestNewWithCorrelationID_UniquenessFromNodeAndTimestamp(t *testing.T) {
+	const timestamp = 1 << 40
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	first, err := newWithCorrelationTag(timestamp, "req-1234", node)
+	if err != nil {
+		t.Fatalf("newWithCorrelationTag failed: %v", err)
+	}
+
+	// The clock sequence holds no random bits, so the same node and timestamp repeat the UUID
+	second, err := newWithCorrelationTag(timestamp, "req-1234", node)
+	if err != nil {
+		t.Fatalf("newWithCorrelationTag failed: %v", err)
+	}
+	if first != second {
+		t.Errorf("Expected the same UUID for the same node, timestamp and correlation ID: %s, %s", first, second)
+	}
+
+	// A different node or timestamp keeps the UUIDs apart
+	otherNode, err := newWithCorrelationTag(timestamp, "req-1234", []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x07})
+	if err != nil {
+		t.Fatalf("newWithCorrelationTag failed: %v", err)
+	}
+	later, err := newWithCorrelationTag(timestamp+1, "req-1234", node)
+	if err != nil {
+		t.Fatalf("newWithCorrelationTag failed: %v", err)
+	}
+	if otherNode == first || later == first {
+		t.Errorf("Expected distinct UUIDs for a different node or timestamp: %s, %s, %s", first, otherNode, later)
+	}
+}
@@ -0,0 +1,86 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithCorrelationID(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	parent, err := uuidv8.New()
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	child, err := uuidv8.NewWithCorrelationID("req-1234", node)
+	if err != nil {
+		t.Fatalf("NewWithCorrelationID failed: %v", err)
+	}
+	if !uuidv8.IsValidUUIDv8(child) {
+		t.Errorf("NewWithCorrelationID generated an invalid UUIDv8: %s", child)
+	}
+
+	if !uuidv8.AreCorrelated(parent, child, "req-1234") {
+		t.Errorf("Expected %s to be correlated with %s", child, parent)
+	}
+	if uuidv8.AreCorrelated(child, parent, "req-1234") {
+		t.Error("A parent generated after the child should not be correlated")
+	}
+}
+
+func TestNewWithCorrelationID_SharedTag(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	first, err := uuidv8.NewWithCorrelationID("req-1234", node)
+	if err != nil {
+		t.Fatalf("NewWithCorrelationID failed: %v", err)
+	}
+	second, err := uuidv8.NewWithCorrelationID("req-1234", node)
+	if err != nil {
+		t.Fatalf("NewWithCorrelationID failed: %v", err)
+	}
+
+	a, _ := uuidv8.FromString(first)
+	b, _ := uuidv8.FromString(second)
+	if a.ClockSeq != b.ClockSeq {
+		t.Errorf("Children of the same correlation ID should share a clock sequence: %03x, %03x", a.ClockSeq, b.ClockSeq)
+	}
+}
+
+func TestAreCorrelated_Mismatches(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	parent, _ := uuidv8.New()
+	child, err := uuidv8.NewWithCorrelationID("req-1234", node)
+	if err != nil {
+		t.Fatalf("NewWithCorrelationID failed: %v", err)
+	}
+
+	tests := []struct {
+		parent, child, correlationID string
+		description                  string
+	}{
+		{parent, child, "req-5678", "Different correlation ID"},
+		{"invalid-uuid", child, "req-1234", "Invalid parent"},
+		{parent, "invalid-uuid", "req-1234", "Invalid child"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if uuidv8.AreCorrelated(test.parent, test.child, test.correlationID) {
+				t.Errorf("Expected %s and %s not to be correlated for %q", test.parent, test.child, test.correlationID)
+			}
+		})
+	}
+}
+
+func TestNewWithCorrelationID_InvalidInputs(t *testing.T) {
+	if _, err := uuidv8.NewWithCorrelationID("", []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}); err == nil {
+		t.Error("Expected error for empty correlation ID")
+	}
+	if _, err := uuidv8.NewWithCorrelationID("req-1234", []byte{0x01}); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+}
@@ -0,0 +1,90 @@
+package uuidv8
+
+import (
+	"fmt"
+	"slices"
+)
+
+// BatchDiff holds the set difference between two batches of UUIDs.
+type BatchDiff struct {
+	Added     []string // UUIDs present only in the after batch.
+	Removed   []string // UUIDs present only in the before batch.
+	Unchanged []string // UUIDs present in both batches.
+}
+
+// DiffBatch computes which UUIDs were added, removed, or left unchanged between two checkpoints.
+//
+// The inputs do not need to be sorted and are not modified: copies are sorted internally
+// (O(n log n)) and then merged (O(n)). Every UUID is first converted to the canonical lowercase
+// form, so the same UUID in different representations (uppercase, compact, braced or URN) is
+// treated as unchanged. Duplicates are collapsed, and the slices in the result are sorted and
+// hold canonical UUIDs.
+//
+// Parameters:
+// - before: The UUIDs at the first checkpoint.
+// - after: The UUIDs at the second checkpoint.
+//
+// Returns:
+// - The BatchDiff between the two batches.
+// - An error if any string in either batch is not a valid UUIDv8.
+func DiffBatch(before, after []string) (BatchDiff, error) {
+	beforeSet, err := sortedSet(before)
+	if err != nil {
+		return BatchDiff{}, err
+	}
+	afterSet, err := sortedSet(after)
+	if err != nil {
+		return BatchDiff{}, err
+	}
+
+	return DiffSorted(beforeSet, afterSet), nil
+}
+
+// Helper function to return a sorted copy of a batch in canonical form with duplicates removed.
+func sortedSet(batch []string) ([]string, error) {
+	sorted := make([]string, len(batch))
+	for i, uuid := range batch {
+		uuidBytes, err := parseUUIDv8(uuid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid UUIDv8 in batch: %s: %w", uuid, err)
+		}
+		sorted[i] = formatUUID(uuidBytes, false)
+	}
+	slices.Sort(sorted)
+	return slices.Compact(sorted), nil
+}
+
+// DiffSorted computes the BatchDiff of two batches that are already sorted in ascending order.
+//
+// This is the O(n) merge step of DiffBatch for callers that can guarantee sorted input. The
+// inputs are not validated; unsorted input produces an undefined result.
+//
+// Parameters:
+// - before: The sorted UUIDs at the first checkpoint.
+// - after: The sorted UUIDs at the second checkpoint.
+//
+// Returns:
+// - The BatchDiff between the two batches.
+func DiffSorted(before, after []string) BatchDiff {
+	var diff BatchDiff
+
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] < after[j]:
+			diff.Removed = append(diff.Removed, before[i])
+			i++
+		case before[i] > after[j]:
+			diff.Added = append(diff.Added, after[j])
+			j++
+		default:
+			diff.Unchanged = append(diff.Unchanged, before[i])
+			i++
+			j++
+		}
+	}
+	diff.Removed = append(diff.Removed, before[i:]...)
+	diff.Added = append(diff.Added, after[j:]...)
+
+	return diff
+}
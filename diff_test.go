@@ -0,0 +1,104 @@
+package uuidv8_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestDiffBatch(t *testing.T) {
+	a := "0000075b-cd15-8880-0102-030405060000"
+	b := "01b69b4f-0000-8880-0102-030405060000"
+	c := "9a3d4049-0e2c-8080-0102-030405060000"
+	d := "9a3d4049-0e2c-8080-0102-030405070000"
+
+	tests := []struct {
+		name     string
+		before   []string
+		after    []string
+		expected uuidv8.BatchDiff
+	}{
+		{
+			name:   "Mixed changes with unsorted input",
+			before: []string{c, a, b},
+			after:  []string{d, b, c},
+			expected: uuidv8.BatchDiff{
+				Added:     []string{d},
+				Removed:   []string{a},
+				Unchanged: []string{b, c},
+			},
+		},
+		{
+			name:     "Only additions",
+			before:   nil,
+			after:    []string{b, a},
+			expected: uuidv8.BatchDiff{Added: []string{a, b}},
+		},
+		{
+			name:     "Only removals",
+			before:   []string{b, a},
+			after:    []string{},
+			expected: uuidv8.BatchDiff{Removed: []string{a, b}},
+		},
+		{
+			name:     "Duplicates are collapsed",
+			before:   []string{a, a, b},
+			after:    []string{b, b},
+			expected: uuidv8.BatchDiff{Removed: []string{a}, Unchanged: []string{b}},
+		},
+		{
+			name:     "Representations are canonicalized",
+			before:   []string{"9A3D4049-0E2C-8080-0102-030405060000", "{0000075b-cd15-8880-0102-030405060000}"},
+			after:    []string{"9a3d40490e2c80800102030405060000", "urn:uuid:" + b},
+			expected: uuidv8.BatchDiff{Added: []string{b}, Removed: []string{a}, Unchanged: []string{c}},
+		},
+		{
+			name:     "Empty batches",
+			expected: uuidv8.BatchDiff{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := append([]string(nil), test.before...)
+
+			diff, err := uuidv8.DiffBatch(test.before, test.after)
+			if err != nil {
+				t.Fatalf("DiffBatch failed: %v", err)
+			}
+			if !reflect.DeepEqual(diff, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, diff)
+			}
+			if !reflect.DeepEqual(before, test.before) {
+				t.Errorf("DiffBatch modified its input: %v", test.before)
+			}
+		})
+	}
+}
+
+func TestDiffBatch_InvalidUUIDs(t *testing.T) {
+	valid := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	if _, err := uuidv8.DiffBatch([]string{valid, "invalid-uuid"}, nil); err == nil {
+		t.Error("Expected error for invalid UUID in before batch")
+	}
+	if _, err := uuidv8.DiffBatch(nil, []string{"0193bde4-a9fa-77eb-a304-6cf8530ece78"}); err == nil {
+		t.Error("Expected error for UUIDv7 in after batch")
+	}
+}
+
+func TestDiffSorted(t *testing.T) {
+	before := []string{"a", "b", "d"}
+	after := []string{"b", "c", "d", "e"}
+
+	expected := uuidv8.BatchDiff{
+		Added:     []string{"c", "e"},
+		Removed:   []string{"a"},
+		Unchanged: []string{"b", "d"},
+	}
+
+	if diff := uuidv8.DiffSorted(before, after); !reflect.DeepEqual(diff, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, diff)
+	}
+}
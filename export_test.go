@@ -0,0 +1,8 @@
+package uuidv8
+
+// Hooks exposing unexported helpers to the uuidv8_test package.
+var (
+	UnregisterFormat      = unregisterFormat
+	UnregisterNodeDecoder = unregisterNodeDecoder
+	UnregisterNetwork     = unregisterNetwork
+)
@@ -0,0 +1,103 @@
+package uuidv8
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Field identifies a component of a UUIDv8 struct.
+type Field int
+
+// UUIDv8 fields that can be replaced with Replace.
+const (
+	FieldTimestamp Field = iota // 8 bytes, big-endian
+	FieldClockSeq               // 2 bytes, big-endian
+	FieldNode                   // 6 bytes
+)
+
+// Replace returns a copy of the UUIDv8 with one field replaced, leaving the original unchanged.
+//
+// Parameters:
+// - field: The field to replace.
+// - value: The new value: 8 big-endian bytes (timestamp), 2 big-endian bytes (12-bit clock sequence), or 6 bytes (node).
+//
+// Returns:
+// - A pointer to the new UUIDv8 struct.
+// - An error if the receiver is nil, the field is unknown, the value has the wrong length, or the clock sequence exceeds 12 bits.
+func (u *UUIDv8) Replace(field Field, value []byte) (*UUIDv8, error) {
+	if u == nil {
+		return nil, errors.New("cannot replace a field of a nil UUIDv8")
+	}
+
+	switch field {
+	case FieldTimestamp:
+		if len(value) != 8 {
+			return nil, fmt.Errorf("timestamp must be 8 bytes, got %d bytes", len(value))
+		}
+		return u.WithTimestamp(binary.BigEndian.Uint64(value)), nil
+	case FieldClockSeq:
+		if len(value) != 2 {
+			return nil, fmt.Errorf("clock sequence must be 2 bytes, got %d bytes", len(value))
+		}
+		cs := binary.BigEndian.Uint16(value)
+		if cs > 0x0FFF {
+			return nil, fmt.Errorf("clock sequence %#x exceeds 12 bits", cs)
+		}
+		return u.WithClockSeq(cs), nil
+	case FieldNode:
+		return u.WithNode(value)
+	default:
+		return nil, fmt.Errorf("unknown field: %d", field)
+	}
+}
+
+// WithTimestamp returns a copy of the UUIDv8 with the given timestamp.
+//
+// A nil receiver is treated as a zero UUIDv8.
+func (u *UUIDv8) WithTimestamp(ts uint64) *UUIDv8 {
+	c := u.clone()
+	c.Timestamp = ts
+	return c
+}
+
+// WithClockSeq returns a copy of the UUIDv8 with the given clock sequence.
+//
+// A nil receiver is treated as a zero UUIDv8. The clock sequence is masked to 12 bits, since the
+// upper bits would overwrite the version when encoded.
+func (u *UUIDv8) WithClockSeq(cs uint16) *UUIDv8 {
+	c := u.clone()
+	c.ClockSeq = cs & 0x0FFF
+	return c
+}
+
+// WithNode returns a copy of the UUIDv8 with the given node.
+//
+// A nil receiver is treated as a zero UUIDv8. The node is copied, so later changes to it do not
+// affect the returned UUIDv8.
+//
+// Returns:
+// - A pointer to the new UUIDv8 struct.
+// - An error if the node is not 6 bytes.
+func (u *UUIDv8) WithNode(node []byte) (*UUIDv8, error) {
+	if len(node) != 6 {
+		return nil, fmt.Errorf("node must be 6 bytes, got %d bytes", len(node))
+	}
+
+	c := u.clone()
+	c.Node = append([]byte(nil), node...)
+	return c, nil
+}
+
+// Helper function to deep-copy a UUIDv8 struct, treating nil as a zero UUIDv8.
+func (u *UUIDv8) clone() *UUIDv8 {
+	if u == nil {
+		return &UUIDv8{}
+	}
+
+	c := *u
+	if u.Node != nil {
+		c.Node = append([]byte(nil), u.Node...)
+	}
+	return &c
+}
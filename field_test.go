@@ -0,0 +1,123 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestReplace(t *testing.T) {
+	original := &uuidv8.UUIDv8{
+		Timestamp: 123456789,
+		ClockSeq:  0x0800,
+		Node:      []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06},
+	}
+
+	t.Run("Replace timestamp", func(t *testing.T) {
+		replaced, err := original.Replace(uuidv8.FieldTimestamp, []byte{0, 0, 0, 0, 0, 0, 0x01, 0x02})
+		if err != nil {
+			t.Fatalf("Replace failed: %v", err)
+		}
+		if replaced.Timestamp != 0x0102 {
+			t.Errorf("Expected timestamp 0x0102, got %#x", replaced.Timestamp)
+		}
+		if replaced.ClockSeq != original.ClockSeq || !bytes.Equal(replaced.Node, original.Node) {
+			t.Errorf("Unrelated fields changed: %+v", replaced)
+		}
+	})
+
+	t.Run("Replace clock sequence", func(t *testing.T) {
+		replaced, err := original.Replace(uuidv8.FieldClockSeq, []byte{0x01, 0x23})
+		if err != nil {
+			t.Fatalf("Replace failed: %v", err)
+		}
+		if replaced.ClockSeq != 0x0123 {
+			t.Errorf("Expected clock sequence 0x0123, got %#x", replaced.ClockSeq)
+		}
+	})
+
+	t.Run("Replace node", func(t *testing.T) {
+		node := []byte{0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F}
+		replaced, err := original.Replace(uuidv8.FieldNode, node)
+		if err != nil {
+			t.Fatalf("Replace failed: %v", err)
+		}
+		if !bytes.Equal(replaced.Node, node) {
+			t.Errorf("Expected node %x, got %x", node, replaced.Node)
+		}
+
+		// The replaced node must not alias the caller's slice
+		node[0] = 0xFF
+		if replaced.Node[0] == 0xFF {
+			t.Error("Replaced node aliases the input slice")
+		}
+	})
+
+	// The original must never change
+	if original.Timestamp != 123456789 || original.ClockSeq != 0x0800 ||
+		!bytes.Equal(original.Node, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}) {
+		t.Errorf("Original UUIDv8 was modified: %+v", original)
+	}
+}
+
+func TestReplace_ErrorCases(t *testing.T) {
+	original := &uuidv8.UUIDv8{Timestamp: 1, Node: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}}
+
+	tests := []struct {
+		field       uuidv8.Field
+		value       []byte
+		description string
+	}{
+		{uuidv8.FieldTimestamp, []byte{0x01}, "Short timestamp"},
+		{uuidv8.FieldClockSeq, []byte{0x01, 0x02, 0x03}, "Long clock sequence"},
+		{uuidv8.FieldClockSeq, []byte{0x10, 0x00}, "Clock sequence exceeding 12 bits"},
+		{uuidv8.FieldNode, []byte{0x01, 0x02}, "Short node"},
+		{uuidv8.Field(99), []byte{0x01}, "Unknown field"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := original.Replace(test.field, test.value); err == nil {
+				t.Errorf("Expected error for %s", test.description)
+			}
+		})
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if _, err := nilUUID.Replace(uuidv8.FieldClockSeq, []byte{0x01, 0x02}); err == nil {
+		t.Error("Expected error when replacing a field of a nil UUIDv8")
+	}
+}
+
+func TestWithFields_Builder(t *testing.T) {
+	var base *uuidv8.UUIDv8
+
+	built, err := base.WithTimestamp(123456789).WithClockSeq(0x0800).WithNode([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06})
+	if err != nil {
+		t.Fatalf("WithNode failed: %v", err)
+	}
+
+	if result := uuidv8.ToString(built); result != "0000075b-cd15-8880-0102-030405060000" {
+		t.Errorf("Unexpected UUIDv8: %s", result)
+	}
+
+	// Modifying a derived UUIDv8 must not affect the one it was derived from
+	derived := built.WithClockSeq(0x0001)
+	derived.Node[0] = 0xFF
+	if built.ClockSeq != 0x0800 || built.Node[0] != 0x01 {
+		t.Errorf("Derived UUIDv8 shares state with its source: %+v", built)
+	}
+}
+
+func TestWithClockSeq_Masks12Bits(t *testing.T) {
+	base := &uuidv8.UUIDv8{Timestamp: 1, Node: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}}
+
+	derived := base.WithClockSeq(0xF123)
+	if derived.ClockSeq != 0x0123 {
+		t.Errorf("Expected clock sequence masked to 0x0123, got %#x", derived.ClockSeq)
+	}
+	if !uuidv8.IsValidUUIDv8(uuidv8.ToString(derived)) {
+		t.Errorf("Expected a valid UUIDv8 after masking, got %s", uuidv8.ToString(derived))
+	}
+}
@@ -0,0 +1,50 @@
+package uuidv8
+
+import (
+	"fmt"
+	"time"
+)
+
+// FeatureFlagsMask covers the bits that NewWithFeatureFlags can store.
+//
+// Flags live in the 12-bit clock sequence field, but bits 6 and 7 of that field are shared with
+// the variant bits and are overwritten when the UUID is encoded, leaving 10 usable flag bits.
+const FeatureFlagsMask = clockSeqMask
+
+// NewWithFeatureFlags generates a UUIDv8 that carries a set of feature flags in its clock sequence.
+//
+// Embedding the active flags of an A/B test or feature rollout lets analytics group events by
+// flag set straight from the ID. The clock sequence field is 12 bits wide (flags & 0x0FFF), of
+// which bits 6 and 7 are taken by the variant; see FeatureFlagsMask. Since the clock sequence is
+// no longer random, uniqueness within a timestamp relies on the node.
+//
+// Parameters:
+// - flags: The feature flags. Only bits within FeatureFlagsMask may be set.
+// - node: A 6-byte slice representing a unique identifier.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if flags uses bits outside FeatureFlagsMask or the node is invalid.
+func NewWithFeatureFlags(flags uint16, node []byte) (string, error) {
+	if flags&^FeatureFlagsMask != 0 {
+		return "", fmt.Errorf("feature flags %#04x use bits outside of mask %#04x", flags, FeatureFlagsMask)
+	}
+
+	return NewWithParams(uint64(time.Now().UnixNano()), flags, node, TimestampBits48)
+}
+
+// ExtractFeatureFlags returns the feature flags of a UUIDv8 generated by NewWithFeatureFlags.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The feature flags stored in the clock sequence.
+// - An error if the input is not a valid UUIDv8.
+func ExtractFeatureFlags(uuid string) (uint16, error) {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract feature flags: %w", err)
+	}
+	return decodeUUIDv8(uuidBytes).ClockSeq & FeatureFlagsMask, nil
+}
@@ -0,0 +1,58 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithFeatureFlags(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	tests := []struct {
+		flags       uint16
+		description string
+	}{
+		{0, "No flags"},
+		{0x0001, "Single flag"},
+		{0x0A2B, "Mixed flags"},
+		{uuidv8.FeatureFlagsMask, "All usable flags"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithFeatureFlags(test.flags, node)
+			if err != nil {
+				t.Fatalf("NewWithFeatureFlags failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithFeatureFlags generated an invalid UUIDv8: %s", uuid)
+			}
+
+			flags, err := uuidv8.ExtractFeatureFlags(uuid)
+			if err != nil {
+				t.Fatalf("ExtractFeatureFlags failed: %v", err)
+			}
+			if flags != test.flags {
+				t.Errorf("Flags mismatch: expected %#04x, got %#04x", test.flags, flags)
+			}
+		})
+	}
+}
+
+func TestNewWithFeatureFlags_InvalidInputs(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	for _, flags := range []uint16{0x1000, 0x0040, 0x0080, 0xFFFF} {
+		if _, err := uuidv8.NewWithFeatureFlags(flags, node); err == nil {
+			t.Errorf("Expected error for flags %#04x", flags)
+		}
+	}
+
+	if _, err := uuidv8.NewWithFeatureFlags(0x0001, []byte{0x01}); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+	if _, err := uuidv8.ExtractFeatureFlags("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
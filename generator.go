@@ -0,0 +1,336 @@
+package uuidv8
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// maxSequence is the number of distinct clock sequence values that survive encoding (see clockSeqMask).
+const maxSequence = 1 << 10
+
+// attributedSequence is the number of clock sequence values left when a generator tag occupies 4 of the 10 bits.
+const attributedSequence = 1 << 6
+
+// maxClockRegression is the largest backwards clock step, in milliseconds, that Generator.New absorbs.
+const maxClockRegression = 10
+
+// ErrClockRegression is returned by Generator.New when the clock moved backwards by more than it can absorb.
+var ErrClockRegression = errors.New("clock moved backwards")
+
+// Generator generates UUIDv8s that sort in generation order.
+//
+// New picks a random clock sequence on every call, so UUIDs generated within the same clock tick
+// (the system clock only advances every 15ms on Windows and in many VMs) are not ordered. A
+// Generator instead tracks the last timestamp and increments the clock sequence while the
+// timestamp does not advance, so each UUID is strictly greater than the previous one. All UUIDs
+// share a node chosen on first use: random by default, or from the WithNodeFunc option.
+//
+// The timestamp is the Unix time in milliseconds rather than nanoseconds: nanoseconds overflow
+// the 48-bit timestamp field every 78 hours, which would break the ordering of long-running
+// generators, while milliseconds fit for the next few thousand years.
+//
+// A Generator is safe for concurrent use.
+type Generator struct {
+	// GeneratorID identifies the Generator. It is assigned randomly by NewGenerator.
+	GeneratorID [8]byte
+
+	mu            sync.Mutex
+	now           func() uint64
+	node          []byte
+	nodeFunc      func() ([]byte, error)
+	fallback      *Generator
+	attributed    bool
+	customVariant bool
+	variant       byte
+	lastTimestamp uint64
+	sequence      uint16
+}
+
+// Option configures a Generator created by NewGenerator.
+type Option func(*Generator)
+
+// WithAttribution makes the Generator embed a 4-bit tag derived from its GeneratorID in the clock
+// sequence of every UUID, so IsGeneratedBy can attribute UUIDs to it.
+//
+// The tag takes 4 of the 10 clock sequence bits that survive encoding, leaving 64 instead of 1024
+// UUIDs per millisecond before New waits for the clock to advance. Only use it when attribution
+// matters more than high-frequency generation.
+func WithAttribution() Option {
+	return func(g *Generator) {
+		g.attributed = true
+	}
+}
+
+// WithNodeFunc makes the Generator take its node from f instead of generating a random one.
+//
+// f is called on first use and again on every New until it succeeds, e.g. to read a MAC address
+// or a database-assigned ID. It must return 6 bytes.
+func WithNodeFunc(f func() ([]byte, error)) Option {
+	return func(g *Generator) {
+		g.nodeFunc = f
+	}
+}
+
+// WithVariant makes the Generator set the given variant bits instead of the RFC4122 variant.
+//
+// Like NewWithCustomVariant, this is meant for legacy platforms that expect NCS or Microsoft
+// variant bits; the UUIDs are only valid UUIDv8s for VariantRFC4122, so validate them with
+// IsValidUUIDv8WithVariant. New returns an error if variant is greater than 3.
+func WithVariant(variant byte) Option {
+	return func(g *Generator) {
+		g.customVariant, g.variant = true, variant
+	}
+}
+
+// NewGenerator creates a Generator that uses the current Unix time in milliseconds as timestamp.
+//
+// Parameters:
+// - opts: Options applied to the Generator in order.
+//
+// Returns:
+// - A new Generator with a random GeneratorID.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{now: func() uint64 { return uint64(time.Now().UnixMilli()) }}
+	if _, err := rand.Read(g.GeneratorID[:]); err != nil {
+		// Still distinguishes generators created at different times
+		binary.BigEndian.PutUint64(g.GeneratorID[:], uint64(time.Now().UnixNano()))
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// New generates the next UUIDv8 of the sequence.
+//
+// While the clock does not advance, or moves backwards by at most 10ms, the previous timestamp is
+// reused and the clock sequence is incremented. Only the 10 clock sequence bits that survive
+// encoding are used, so once 1024 UUIDs (64 with WithAttribution) have been generated for one
+// timestamp, New waits for the clock to advance; the wait is bounded by the tolerated backwards
+// step.
+//
+// If generation fails and a fallback was set with WithFallback, the UUID of the fallback is
+// returned instead.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the variant set with WithVariant is invalid, the node cannot be generated on first use, the clock moved backwards by more than 10ms (ErrClockRegression), or the timestamp does not fit in 48 bits, and the fallback (if any) failed too.
+func (g *Generator) New() (string, error) {
+	uuid, fallback, err := g.generate()
+	if err == nil || fallback == nil {
+		return uuid, err
+	}
+
+	// The lock is released, so the fallback may be shared with other chains
+	fallbackUUID, fallbackErr := fallback.New()
+	if fallbackErr != nil {
+		return "", fmt.Errorf("%w (fallback failed: %w)", err, fallbackErr)
+	}
+	return fallbackUUID, nil
+}
+
+// WithFallback sets the Generator that New falls back to when g fails, e.g. because its node source
+// is unavailable, and returns g.
+//
+// The fallback may have a fallback of its own, so chains can be arbitrarily deep, but they must
+// not form a cycle. UUIDs of the fallback carry its own node and are not ordered relative to the
+// UUIDs of g.
+//
+// Parameters:
+// - fallback: The Generator to use when g fails, or nil to remove the fallback.
+//
+// Returns:
+// - The receiver, g.
+func (g *Generator) WithFallback(fallback *Generator) *Generator {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.fallback = fallback
+	return g
+}
+
+// NewWithFallback chains two generators, so that fallback generates the UUIDs while primary fails.
+//
+// Parameters:
+// - primary: The preferred Generator. If nil, a new Generator is used.
+// - fallback: The Generator to use when primary fails.
+//
+// Returns:
+// - The primary Generator, with its fallback set.
+func NewWithFallback(primary, fallback *Generator) *Generator {
+	if primary == nil {
+		primary = NewGenerator()
+	}
+	return primary.WithFallback(fallback)
+}
+
+// Helper function to generate the next UUIDv8 of a Generator, also returning its fallback.
+func (g *Generator) generate() (string, *Generator, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	variant := byte(variantRFC4122)
+	if g.customVariant {
+		if g.variant > 0b11 {
+			return "", g.fallback, fmt.Errorf("variant must be between 0 and 3, got %d", g.variant)
+		}
+		variant = g.variant
+	}
+
+	if g.node == nil {
+		node, err := g.newNode()
+		if err != nil {
+			return "", g.fallback, err
+		}
+		g.node = node
+	}
+
+	timestamp, err := g.nextTimestamp()
+	if err != nil {
+		return "", g.fallback, err
+	}
+
+	clockSeq := sequenceClockSeq(g.sequence)
+	if g.attributed {
+		clockSeq = uint16(g.tag())<<8 | g.sequence
+	}
+	uuid, err := buildUUID(timestamp, clockSeq, g.node, TimestampBits48, variant)
+	if err != nil {
+		return "", g.fallback, err
+	}
+	return formatUUID(uuid, false), g.fallback, nil
+}
+
+// Helper function to obtain the node of a Generator from its node function, or randomly.
+func (g *Generator) newNode() ([]byte, error) {
+	if g.nodeFunc == nil {
+		return randomNode()
+	}
+
+	node, err := g.nodeFunc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate node: %w", err)
+	}
+	if len(node) != 6 {
+		return nil, fmt.Errorf("node must be 6 bytes, got %d bytes", len(node))
+	}
+	// Copy so later changes by the node function do not affect the Generator
+	return append([]byte(nil), node...), nil
+}
+
+// IsGeneratedBy reports whether the UUIDv8 carries the attribution tag of the given Generator.
+//
+// The tag is only 4 bits wide, so a UUID from another generator matches with a probability of
+// 1 in 16; use it for routing and debugging, not for security decisions.
+//
+// Parameters:
+// - g: A Generator created with the WithAttribution option.
+//
+// Returns:
+// - True if g embeds attribution tags and the UUID's clock sequence carries g's tag, false otherwise.
+func (u *UUIDv8) IsGeneratedBy(g *Generator) bool {
+	if u == nil || g == nil || !g.attributed {
+		return false
+	}
+	return uint8(u.ClockSeq>>8)&0x0F == g.tag()
+}
+
+// Helper function to derive the 4-bit attribution tag from the low byte of the GeneratorID.
+func (g *Generator) tag() uint8 {
+	return g.GeneratorID[len(g.GeneratorID)-1] & 0x0F
+}
+
+// Close releases the resources held by the Generator.
+//
+// A Generator has no background goroutine or buffer of pre-generated UUIDs, so Close does nothing
+// and always returns nil. It exists so generators can be shut down uniformly, e.g. with defer.
+//
+// Returns:
+// - Always nil.
+func (g *Generator) Close() error {
+	return nil
+}
+
+// Helper function to advance the timestamp and clock sequence of a Generator. The caller must hold g.mu.
+func (g *Generator) nextTimestamp() (uint64, error) {
+	for {
+		timestamp := g.now()
+		switch {
+		case timestamp >= 1<<48:
+			return 0, fmt.Errorf("timestamp %d exceeds 48 bits", timestamp)
+		case timestamp > g.lastTimestamp:
+			g.lastTimestamp, g.sequence = timestamp, 0
+			return timestamp, nil
+		case g.lastTimestamp-timestamp > maxClockRegression:
+			return 0, fmt.Errorf("%w by %dms", ErrClockRegression, g.lastTimestamp-timestamp)
+		case g.sequence+1 < g.sequenceLimit():
+			g.sequence++
+			return g.lastTimestamp, nil
+		}
+
+		// Clock sequence exhausted; the clock is at most maxClockRegression behind, so this wait is short
+		runtime.Gosched()
+	}
+}
+
+// Helper function to return the number of clock sequence values available per timestamp.
+func (g *Generator) sequenceLimit() uint16 {
+	if g.attributed {
+		return attributedSequence
+	}
+	return maxSequence
+}
+
+// NewBatch generates n UUIDv8s in ascending order with a single read of random data.
+//
+// All UUIDs share the current timestamp and a random node; the clock sequence starts at a random
+// value and is incremented for each UUID, so the batch is sorted. Like Generator, the timestamp
+// is the Unix time in milliseconds, so batches do not wrap and sort with Generator output. Since
+// only 1024 clock sequence values survive encoding, a batch holds at most 1024 UUIDs; the larger
+// the batch, the smaller the random range of the starting clock sequence.
+//
+// Parameters:
+// - n: The number of UUIDs to generate. If n <= 0, an empty slice is returned.
+//
+// Returns:
+// - The generated UUIDv8 strings in ascending order.
+// - An error if n exceeds 1024 or random data cannot be generated.
+func NewBatch(n int) ([]string, error) {
+	if n <= 0 {
+		return []string{}, nil
+	}
+	if n > maxSequence {
+		return nil, fmt.Errorf("batch size %d exceeds the %d available clock sequence values", n, maxSequence)
+	}
+
+	// One read covers the 6-byte node and the starting clock sequence
+	entropy := make([]byte, 8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, fmt.Errorf("failed to generate random batch data: %w", err)
+	}
+	node := entropy[:6]
+	start := binary.BigEndian.Uint16(entropy[6:]) % uint16(maxSequence-n+1)
+
+	timestamp := uint64(time.Now().UnixMilli())
+	uuids := make([]string, n)
+	for i := range uuids {
+		uuid, err := NewWithParams(timestamp, sequenceClockSeq(start+uint16(i)), node, TimestampBits48)
+		if err != nil {
+			return nil, err
+		}
+		uuids[i] = uuid
+	}
+	return uuids, nil
+}
+
+// Helper function to spread a 10-bit sequence over the clock sequence bits that survive encoding.
+//
+// The resulting clock sequences sort in the same order as the sequence values.
+func sequenceClockSeq(sequence uint16) uint16 {
+	return (sequence>>6)<<8 | sequence&0x3F
+}
@@ -0,0 +1,168 @@
+package uuidv8
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGenerator_ClockSequence(t *testing.T) {
+	const timestamp = 1 << 40
+
+	clock := uint64(timestamp)
+	g := &Generator{now: func() uint64 { return clock }}
+
+	var prev string
+	for i := 0; i < maxSequence; i++ {
+		uuid, err := g.New()
+		if err != nil {
+			t.Fatalf("Generator.New failed: %v", err)
+		}
+		if uuid <= prev {
+			t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, uuid, prev)
+		}
+		prev = uuid
+
+		parsed, _ := FromString(uuid)
+		if parsed.Timestamp != timestamp {
+			t.Fatalf("Expected timestamp %d, got %d", timestamp, parsed.Timestamp)
+		}
+	}
+
+	// The clock sequence is exhausted; New must wait for the clock to advance
+	calls := 0
+	g.now = func() uint64 {
+		calls++
+		if calls > 3 {
+			return timestamp + 1
+		}
+		return timestamp
+	}
+
+	uuid, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	parsed, _ := FromString(uuid)
+	if parsed.Timestamp != timestamp+1 || parsed.ClockSeq&clockSeqMask != 0 {
+		t.Errorf("Expected timestamp %d with reset clock sequence, got %+v", timestamp+1, parsed)
+	}
+	if uuid <= prev {
+		t.Errorf("UUID after overflow is not greater than its predecessor: %s <= %s", uuid, prev)
+	}
+}
+
+func TestGenerator_ClockBackwards(t *testing.T) {
+	clock := uint64(1 << 40)
+	g := &Generator{now: func() uint64 { return clock }}
+
+	first, _ := g.New()
+	clock -= maxClockRegression
+	second, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed after a small backwards step: %v", err)
+	}
+	if second <= first {
+		t.Errorf("Expected UUIDs to stay ordered when the clock moves backwards: %s <= %s", second, first)
+	}
+}
+
+func TestGenerator_LargeClockRegression(t *testing.T) {
+	clock := uint64(1 << 40)
+	g := &Generator{now: func() uint64 { return clock }}
+
+	first, _ := g.New()
+
+	// A large step must fail immediately instead of blocking until the clock catches up
+	clock -= 60 * 60 * 1000
+	calls := 0
+	g.now = func() uint64 {
+		calls++
+		return clock
+	}
+	if _, err := g.New(); !errors.Is(err, ErrClockRegression) {
+		t.Fatalf("Expected ErrClockRegression, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected a single clock read, got %d", calls)
+	}
+
+	// The generator recovers once the clock has caught up
+	clock += 60*60*1000 + 1
+	next, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed after the clock caught up: %v", err)
+	}
+	if next <= first {
+		t.Errorf("Expected %s to sort after %s", next, first)
+	}
+}
+
+func TestGenerator_TimestampOverflow(t *testing.T) {
+	clock := uint64(1<<48 - 1)
+	g := &Generator{now: func() uint64 { return clock }}
+
+	last, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed for the largest 48-bit timestamp: %v", err)
+	}
+	if parsed, _ := FromString(last); parsed.Timestamp != clock {
+		t.Errorf("Expected timestamp %d, got %d", clock, parsed.Timestamp)
+	}
+
+	// A timestamp that would wrap around must not produce a UUID sorting before its predecessor
+	clock++
+	if uuid, err := g.New(); err == nil {
+		t.Errorf("Expected error for a timestamp exceeding 48 bits, got %s after %s", uuid, last)
+	}
+}
+
+func TestNewGenerator_MillisecondTimestamp(t *testing.T) {
+	before := uint64(time.Now().UnixMilli())
+	uuid, err := NewGenerator().New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	after := uint64(time.Now().UnixMilli())
+
+	parsed, _ := FromString(uuid)
+	if parsed.Timestamp < before || parsed.Timestamp > after {
+		t.Errorf("Expected timestamp between %d and %d, got %d", before, after, parsed.Timestamp)
+	}
+}
+
+func TestGenerator_AttributedSequence(t *testing.T) {
+	const timestamp = 1 << 40
+
+	g := &Generator{now: func() uint64 { return timestamp }, attributed: true}
+	g.GeneratorID[7] = 0x5A
+
+	for i := 0; i < attributedSequence; i++ {
+		uuid, err := g.New()
+		if err != nil {
+			t.Fatalf("Generator.New failed: %v", err)
+		}
+		parsed, _ := FromString(uuid)
+		if parsed.ClockSeq&clockSeqMask != 0x0A00|uint16(i) {
+			t.Fatalf("Expected clock sequence %#x, got %#x", 0x0A00|i, parsed.ClockSeq&clockSeqMask)
+		}
+	}
+
+	// The 64 attributed sequence values are exhausted; New must wait for the clock to advance
+	calls := 0
+	g.now = func() uint64 {
+		calls++
+		if calls > 3 {
+			return timestamp + 1
+		}
+		return timestamp
+	}
+	uuid, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	parsed, _ := FromString(uuid)
+	if parsed.Timestamp != timestamp+1 || parsed.ClockSeq&clockSeqMask != 0x0A00 {
+		t.Errorf("Expected timestamp %d with clock sequence 0xa00, got %+v", timestamp+1, parsed)
+	}
+}
@@ -0,0 +1,313 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestGenerator_Monotonic(t *testing.T) {
+	g := uuidv8.NewGenerator()
+
+	uuids := make([]string, 10000)
+	for i := range uuids {
+		uuid, err := g.New()
+		if err != nil {
+			t.Fatalf("Generator.New failed: %v", err)
+		}
+		if !uuidv8.IsValidUUIDv8(uuid) {
+			t.Fatalf("Generator.New generated an invalid UUIDv8: %s", uuid)
+		}
+		uuids[i] = uuid
+	}
+
+	for i := 1; i < len(uuids); i++ {
+		if uuids[i] <= uuids[i-1] {
+			t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, uuids[i], uuids[i-1])
+		}
+	}
+
+	// All UUIDs of a generator share a node
+	if uuids[0][19:32] != uuids[len(uuids)-1][19:32] {
+		t.Errorf("Expected UUIDs of the same generator to share a node: %s, %s", uuids[0], uuids[len(uuids)-1])
+	}
+}
+
+func TestGenerator_Concurrent(t *testing.T) {
+	g := uuidv8.NewGenerator()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var uuids []string
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				uuid, err := g.New()
+				if err != nil {
+					t.Errorf("Generator.New failed: %v", err)
+					return
+				}
+				mu.Lock()
+				uuids = append(uuids, uuid)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(uuids)
+	if len(slices.Compact(uuids)) != 8*500 {
+		t.Error("Expected all concurrently generated UUIDs to be unique")
+	}
+}
+
+func TestNewBatch(t *testing.T) {
+	for _, n := range []int{1, 2, 100, 1024} {
+		uuids, err := uuidv8.NewBatch(n)
+		if err != nil {
+			t.Fatalf("NewBatch(%d) failed: %v", n, err)
+		}
+		if len(uuids) != n {
+			t.Fatalf("Expected %d UUIDs, got %d", n, len(uuids))
+		}
+
+		for i, uuid := range uuids {
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Fatalf("NewBatch generated an invalid UUIDv8: %s", uuid)
+			}
+			if i > 0 && uuid <= uuids[i-1] {
+				t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, uuid, uuids[i-1])
+			}
+			if uuid[19:32] != uuids[0][19:32] {
+				t.Fatalf("Expected UUIDs of a batch to share a node: %s, %s", uuids[0], uuid)
+			}
+		}
+	}
+}
+
+func TestNewBatch_MillisecondTimestamp(t *testing.T) {
+	before := uint64(time.Now().UnixMilli())
+	uuids, err := uuidv8.NewBatch(10)
+	if err != nil {
+		t.Fatalf("NewBatch failed: %v", err)
+	}
+	after := uint64(time.Now().UnixMilli())
+
+	parsed := uuidv8.FromStringOrNil(uuids[0])
+	if parsed == nil || parsed.Timestamp < before || parsed.Timestamp > after {
+		t.Errorf("Expected a millisecond timestamp between %d and %d, got %+v", before, after, parsed)
+	}
+
+	// Batches sort with Generator output generated afterwards
+	g := uuidv8.NewGenerator()
+	time.Sleep(2 * time.Millisecond)
+	next, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	if next <= uuids[len(uuids)-1] {
+		t.Errorf("Expected Generator output after the batch to sort last: %s <= %s", next, uuids[len(uuids)-1])
+	}
+}
+
+func TestNewBatch_EdgeCases(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		uuids, err := uuidv8.NewBatch(n)
+		if err != nil {
+			t.Errorf("NewBatch(%d) failed: %v", n, err)
+		}
+		if uuids == nil || len(uuids) != 0 {
+			t.Errorf("Expected an empty slice for n=%d, got %v", n, uuids)
+		}
+	}
+
+	if _, err := uuidv8.NewBatch(1025); err == nil {
+		t.Error("Expected error for a batch exceeding the clock sequence")
+	}
+}
+
+func BenchmarkNewBatch(b *testing.B) {
+	b.Run("NewBatch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = uuidv8.NewBatch(100)
+		}
+	})
+	b.Run("New", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 100; j++ {
+				_, _ = uuidv8.New()
+			}
+		}
+	})
+}
+
+func TestGenerator_Close(t *testing.T) {
+	g := uuidv8.NewGenerator()
+	if _, err := g.New(); err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	if err := g.Close(); err != nil {
+		t.Errorf("Generator.Close returned an error: %v", err)
+	}
+}
+
+func TestGenerator_IsGeneratedBy(t *testing.T) {
+	g := uuidv8.NewGenerator(uuidv8.WithAttribution())
+
+	var prev string
+	for i := 0; i < 1000; i++ {
+		uuid, err := g.New()
+		if err != nil {
+			t.Fatalf("Generator.New failed: %v", err)
+		}
+		if uuid <= prev {
+			t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, uuid, prev)
+		}
+		prev = uuid
+
+		parsed, err := uuidv8.FromString(uuid)
+		if err != nil {
+			t.Fatalf("FromString failed: %v", err)
+		}
+		if !parsed.IsGeneratedBy(g) {
+			t.Fatalf("Expected %s to be attributed to its generator", uuid)
+		}
+	}
+}
+
+func TestGenerator_IsGeneratedBy_Unattributed(t *testing.T) {
+	g := uuidv8.NewGenerator()
+	uuid, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	parsed := uuidv8.FromStringOrNil(uuid)
+
+	if parsed.IsGeneratedBy(g) {
+		t.Errorf("Expected a generator without WithAttribution to never match")
+	}
+	if parsed.IsGeneratedBy(nil) {
+		t.Errorf("Expected a nil generator to never match")
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if nilUUID.IsGeneratedBy(uuidv8.NewGenerator(uuidv8.WithAttribution())) {
+		t.Errorf("Expected a nil UUIDv8 to never match")
+	}
+}
+
+func TestGenerator_WithNodeFunc(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	g := uuidv8.NewGenerator(uuidv8.WithNodeFunc(func() ([]byte, error) { return node, nil }))
+
+	uuid, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	if parsed := uuidv8.FromStringOrNil(uuid); parsed == nil || !bytes.Equal(parsed.Node, node) {
+		t.Errorf("Expected node %x in %s", node, uuid)
+	}
+
+	short := uuidv8.NewGenerator(uuidv8.WithNodeFunc(func() ([]byte, error) { return node[:4], nil }))
+	if _, err := short.New(); err == nil {
+		t.Errorf("Expected error for a node function returning 4 bytes")
+	}
+}
+
+func TestGenerator_WithFallback(t *testing.T) {
+	errNodeUnavailable := errors.New("node source unavailable")
+	failing := func() ([]byte, error) { return nil, errNodeUnavailable }
+
+	fallbackNode := []byte{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	last := uuidv8.NewGenerator(uuidv8.WithNodeFunc(func() ([]byte, error) { return fallbackNode, nil }))
+
+	// A chain of two failing generators ending in a working one
+	g := uuidv8.NewWithFallback(
+		uuidv8.NewGenerator(uuidv8.WithNodeFunc(failing)),
+		uuidv8.NewGenerator(uuidv8.WithNodeFunc(failing)).WithFallback(last),
+	)
+
+	uuid, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed despite a working fallback: %v", err)
+	}
+	if parsed := uuidv8.FromStringOrNil(uuid); parsed == nil || !bytes.Equal(parsed.Node, fallbackNode) {
+		t.Errorf("Expected the UUID of the last fallback, got %s", uuid)
+	}
+
+	// Without a working fallback, the errors of the chain are returned
+	g.WithFallback(uuidv8.NewGenerator(uuidv8.WithNodeFunc(failing)))
+	if _, err := g.New(); !errors.Is(err, errNodeUnavailable) {
+		t.Errorf("Expected the node error to propagate, got %v", err)
+	}
+
+	// Removing the fallback
+	g.WithFallback(nil)
+	if _, err := g.New(); !errors.Is(err, errNodeUnavailable) {
+		t.Errorf("Expected the node error without fallback, got %v", err)
+	}
+}
+
+func TestGenerator_WithFallback_PrimaryRecovers(t *testing.T) {
+	available := false
+	primaryNode := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	primary := uuidv8.NewGenerator(uuidv8.WithNodeFunc(func() ([]byte, error) {
+		if !available {
+			return nil, errors.New("node source unavailable")
+		}
+		return primaryNode, nil
+	}))
+	g := uuidv8.NewWithFallback(primary, uuidv8.NewGenerator())
+
+	first, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	if bytes.Equal(uuidv8.FromStringOrNil(first).Node, primaryNode) {
+		t.Errorf("Expected the fallback node while the primary is unavailable, got %s", first)
+	}
+
+	available = true
+	second, err := g.New()
+	if err != nil {
+		t.Fatalf("Generator.New failed: %v", err)
+	}
+	if !bytes.Equal(uuidv8.FromStringOrNil(second).Node, primaryNode) {
+		t.Errorf("Expected the primary node once it is available, got %s", second)
+	}
+}
+
+func TestGenerator_WithVariant(t *testing.T) {
+	for _, variant := range []byte{uuidv8.VariantNCS, uuidv8.VariantRFC4122, uuidv8.VariantMicrosoft} {
+		g := uuidv8.NewGenerator(uuidv8.WithVariant(variant))
+
+		var prev string
+		for i := 0; i < 100; i++ {
+			uuid, err := g.New()
+			if err != nil {
+				t.Fatalf("Generator.New failed for variant %d: %v", variant, err)
+			}
+			if !uuidv8.IsValidUUIDv8WithVariant(uuid, variant) {
+				t.Fatalf("Expected variant %d in %s", variant, uuid)
+			}
+			if uuid <= prev {
+				t.Fatalf("UUID %d is not greater than its predecessor: %s <= %s", i, uuid, prev)
+			}
+			prev = uuid
+		}
+	}
+
+	if _, err := uuidv8.NewGenerator(uuidv8.WithVariant(4)).New(); err == nil {
+		t.Error("Expected error for variant 4")
+	}
+}
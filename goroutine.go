@@ -0,0 +1,75 @@
+package uuidv8
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+)
+
+// NewWithGoroutineID generates a UUIDv8 whose node embeds the ID of the calling goroutine.
+//
+// The goroutine ID is read from the header of the goroutine's stack trace, truncated to 32 bits
+// and stored in the first 4 node bytes; the last 2 node bytes are random. This makes it easy to
+// correlate UUIDs with goroutines seen in pprof profiles when debugging leaks or concurrent
+// access patterns.
+//
+// This is a debugging tool, not a uniqueness guarantee: goroutine IDs are reused by the runtime
+// after a goroutine terminates, and reading the stack trace is comparatively slow.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the goroutine ID cannot be determined or random data cannot be generated.
+func NewWithGoroutineID() (string, error) {
+	id, err := goroutineID()
+	if err != nil {
+		return "", err
+	}
+
+	node := make([]byte, 6)
+	binary.BigEndian.PutUint32(node[:4], uint32(id))
+	if _, err := rand.Read(node[4:]); err != nil {
+		return "", fmt.Errorf("failed to generate random node: %w", err)
+	}
+
+	return newWithNode(node)
+}
+
+// ExtractGoroutineID returns the goroutine ID of a UUIDv8 generated by NewWithGoroutineID.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The goroutine ID (truncated to 32 bits) stored in the first 4 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractGoroutineID(uuid string) (uint32, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract goroutine ID: %w", err)
+	}
+	return binary.BigEndian.Uint32(node[:4]), nil
+}
+
+// Helper function to read the ID of the calling goroutine from its stack trace header ("goroutine 123 [...").
+func goroutineID() (uint64, error) {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+
+	buf, ok := bytes.CutPrefix(buf, []byte("goroutine "))
+	if !ok {
+		return 0, errors.New("failed to determine goroutine ID: unexpected stack trace format")
+	}
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+
+	id, err := strconv.ParseUint(string(buf), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine goroutine ID: %w", err)
+	}
+	return id, nil
+}
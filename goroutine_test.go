@@ -0,0 +1,95 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+// currentGoroutineID parses the goroutine ID independently of the package implementation.
+//
+// It returns an error instead of failing the test, so it can be called from any goroutine.
+func currentGoroutineID() (uint32, error) {
+	buf := make([]byte, 64)
+	fields := bytes.Fields(buf[:runtime.Stack(buf, false)])
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("unexpected stack header: %q", buf)
+	}
+	id, err := strconv.ParseUint(string(fields[1]), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse goroutine ID: %w", err)
+	}
+	return uint32(id), nil
+}
+
+func TestNewWithGoroutineID(t *testing.T) {
+	uuid, err := uuidv8.NewWithGoroutineID()
+	if err != nil {
+		t.Fatalf("NewWithGoroutineID failed: %v", err)
+	}
+	if !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("NewWithGoroutineID generated an invalid UUIDv8: %s", uuid)
+	}
+
+	id, err := uuidv8.ExtractGoroutineID(uuid)
+	if err != nil {
+		t.Fatalf("ExtractGoroutineID failed: %v", err)
+	}
+	expected, err := currentGoroutineID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != expected {
+		t.Errorf("Goroutine ID mismatch: expected %d, got %d", expected, id)
+	}
+}
+
+func TestNewWithGoroutineID_DistinctGoroutines(t *testing.T) {
+	const concurrencyLevel = 10
+	var wg sync.WaitGroup
+	ids := sync.Map{}
+
+	for i := 0; i < concurrencyLevel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uuid, err := uuidv8.NewWithGoroutineID()
+			if err != nil {
+				t.Errorf("NewWithGoroutineID failed: %v", err)
+				return
+			}
+			id, _ := uuidv8.ExtractGoroutineID(uuid)
+			expected, err := currentGoroutineID()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if id != expected {
+				t.Errorf("Goroutine ID mismatch for UUID %s", uuid)
+			}
+			ids.Store(id, true)
+		}()
+	}
+
+	wg.Wait()
+
+	count := 0
+	ids.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+	if count != concurrencyLevel {
+		t.Errorf("Expected %d distinct goroutine IDs, got %d", concurrencyLevel, count)
+	}
+}
+
+func TestExtractGoroutineID_InvalidUUID(t *testing.T) {
+	if _, err := uuidv8.ExtractGoroutineID("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
@@ -1,11 +1,64 @@
 package uuidv8
 
 import (
-	"encoding/hex"
-	"errors"
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// hexValues maps an ASCII character to its hex digit value, or 0xFF if it is not a hex digit.
+var hexValues = func() [256]byte {
+	var values [256]byte
+	for i := range values {
+		values[i] = 0xFF
+	}
+	for i := byte(0); i < 10; i++ {
+		values['0'+i] = i
+	}
+	for i := byte(0); i < 6; i++ {
+		values['a'+i] = 10 + i
+		values['A'+i] = 10 + i
+	}
+	return values
+}()
+
+// Helper function to assemble the UUID byte array from its components and the given variant bits.
+func buildUUID(timestamp uint64, clockSeq uint16, node []byte, timestampBits int, variant byte) ([]byte, error) {
+	if len(node) != 6 {
+		return nil, fmt.Errorf("node must be 6 bytes, got %d bytes", len(node))
+	}
+
+	uuid := make([]byte, 16)
+
+	// Set timestamp
+	if err := encodeTimestamp(uuid, timestamp, timestampBits); err != nil {
+		return nil, err
+	}
+
+	// Set version and clock sequence
+	uuid[6] = (byte(versionV8) << 4) | byte(clockSeq>>8)
+	uuid[7] = byte(clockSeq)
+
+	// Set variant
+	uuid[7] = (uuid[7] & 0x3F) | (variant << 6)
+
+	// Copy node
+	copy(uuid[8:], node)
+
+	return uuid, nil
+}
+
+// Helper function to read n bits (at most 64) starting at the given bit offset of a big-endian byte slice.
+func readBits(b []byte, offset, n int) uint64 {
+	var v uint64
+	for i := offset; i < offset+n; i++ {
+		v = v<<1 | uint64(b[i/8]>>(7-i%8)&1)
+	}
+	return v
+}
+
 // Helper function to encode timestamp into the UUID byte array.
 func encodeTimestamp(uuid []byte, timestamp uint64, timestampBits int) error {
 	switch timestampBits {
@@ -22,38 +75,215 @@ func encodeTimestamp(uuid []byte, timestamp uint64, timestampBits int) error {
 	return nil
 }
 
+// Helper function to generate a random 12-bit clock sequence.
+func randomClockSeq() (uint16, error) {
+	clockSeq := make([]byte, 2)
+	if _, err := rand.Read(clockSeq); err != nil {
+		return 0, fmt.Errorf("failed to generate random clock sequence: %w", err)
+	}
+	return binary.BigEndian.Uint16(clockSeq) & 0x0FFF, nil // Mask to 12 bits
+}
+
+// Helper function to generate a random 6-byte node.
+func randomNode() ([]byte, error) {
+	node := make([]byte, 6)
+	if _, err := rand.Read(node); err != nil {
+		return nil, fmt.Errorf("failed to generate random node: %w", err)
+	}
+	return node, nil
+}
+
+// Helper function to generate a UUIDv8 for the given timestamp with a random clock sequence and node.
+func newWithTimestamp(timestamp uint64) (string, error) {
+	clockSeq, err := randomClockSeq()
+	if err != nil {
+		return "", err
+	}
+
+	node, err := randomNode()
+	if err != nil {
+		return "", err
+	}
+
+	return NewWithParams(timestamp, clockSeq, node, TimestampBits48)
+}
+
+// Helper function to generate a UUIDv8 for the given node with the current timestamp and a random clock sequence.
+func newWithNode(node []byte) (string, error) {
+	clockSeq, err := randomClockSeq()
+	if err != nil {
+		return "", err
+	}
+
+	return NewWithParams(uint64(time.Now().UnixNano()), clockSeq, node, TimestampBits48)
+}
+
+// Helper function to extract the 6-byte node from a UUIDv8 string.
+func extractNode(uuid string) ([]byte, error) {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return uuidBytes[8:14], nil
+}
+
+// clockSeqMask covers the clock sequence bits that survive encoding.
+//
+// The clock sequence is 12 bits wide, but bits 6 and 7 share byte 7 with the variant bits and
+// are overwritten when the UUID is encoded, leaving 10 usable bits. Values that must round-trip
+// through the clock sequence (tags, hashes, counters) are masked with it.
+const clockSeqMask = 0x0F3F
+
+// Helper function to encode the components of a UUIDv8 struct into the UUID byte array.
+func encodeUUIDv8(uuid []byte, u *UUIDv8) {
+	// Encode timestamp (48-bit encoding cannot fail)
+	_ = encodeTimestamp(uuid, u.Timestamp, TimestampBits48)
+
+	// Set clock sequence and version
+	uuid[6] = (byte(versionV8) << 4) | byte(u.ClockSeq>>8)
+	uuid[7] = byte(u.ClockSeq)
+
+	// Set variant
+	uuid[7] = (uuid[7] & 0x3F) | (variantRFC4122 << 6)
+
+	// Copy node
+	copy(uuid[8:], u.Node)
+}
+
+// Helper function to decode the components of a UUIDv8 struct from the UUID byte array.
+func decodeUUIDv8(uuidBytes []byte) *UUIDv8 {
+	return &UUIDv8{
+		Timestamp: decodeTimestamp(uuidBytes[:6]),
+		ClockSeq:  uint16(uuidBytes[6]&0x0F)<<8 | uint16(uuidBytes[7]),
+		Node:      uuidBytes[8:14],
+	}
+}
+
+// Helper function to encode the components of a UUIDv8 struct into a new 16-byte slice.
+func uuidv8Bytes(u *UUIDv8) []byte {
+	uuid := make([]byte, 16)
+	encodeUUIDv8(uuid, u)
+	return uuid
+}
+
+// Helper function to decode a 16-byte slice into a UUIDv8 struct.
+func uuidv8FromBytes(uuidBytes []byte) (*UUIDv8, error) {
+	if len(uuidBytes) != 16 {
+		return nil, fmt.Errorf("UUID must be 16 bytes, got %d bytes", len(uuidBytes))
+	}
+	return decodeUUIDv8(uuidBytes), nil
+}
+
 // Helper function to decode a timestamp from the UUID byte array.
 func decodeTimestamp(uuidBytes []byte) uint64 {
 	return uint64(uuidBytes[0])<<40 | uint64(uuidBytes[1])<<32 | uint64(uuidBytes[2])<<24 |
 		uint64(uuidBytes[3])<<16 | uint64(uuidBytes[4])<<8 | uint64(uuidBytes[5])
 }
 
+// Helper function to decode a timestamp encoded by encodeTimestamp with the given bit size.
+//
+// The 60-bit encoding stores its low 12 bits in byte 6, which the version and clock sequence
+// overwrite, so those bits decode as zero.
+func decodeTimestampWithBits(uuidBytes []byte, timestampBits int) (uint64, error) {
+	switch timestampBits {
+	case TimestampBits32:
+		return uint64(uuidBytes[0])<<24 | uint64(uuidBytes[1])<<16 | uint64(uuidBytes[2])<<8 | uint64(uuidBytes[3]), nil
+	case TimestampBits48:
+		return decodeTimestamp(uuidBytes[:6]), nil
+	case TimestampBits60:
+		return decodeTimestamp(uuidBytes[:6]) << 12, nil
+	default:
+		return 0, fmt.Errorf("unsupported timestamp bit size: %d", timestampBits)
+	}
+}
+
 // Helper function to parse and sanitize a UUID string.
-// Helper function to parse and sanitize a UUID string.
+//
+// Accepted formats:
+// - Compact: 32 hex characters.
+// - Canonical: 36 characters, 8-4-4-4-12 hex groups separated by dashes.
+// - Braced: the canonical form wrapped in curly braces.
+// - URN: the canonical form prefixed with "urn:uuid:" (case-insensitive).
 func parseUUID(uuid string) ([]byte, error) {
 	switch len(uuid) {
 	case 32:
-		// Fast path for UUIDs without dashes
-		return hex.DecodeString(uuid)
+		return decodeHexUUID(uuid, false)
 	case 36:
-		// Validate dash positions
-		if uuid[8] != '-' || uuid[13] != '-' || uuid[18] != '-' || uuid[23] != '-' {
-			return nil, errors.New("invalid UUID format")
+		return decodeHexUUID(uuid, true)
+	case 38:
+		if uuid[0] != '{' || uuid[37] != '}' {
+			return nil, ErrInvalidFormat
 		}
-
-		// Remove dashes while copying characters
-		result := make([]byte, 32)
-		j := 0
-		for i := 0; i < len(uuid); i++ {
-			if uuid[i] != '-' {
-				result[j] = uuid[i]
-				j++
-			}
+		return decodeHexUUID(uuid[1:37], true)
+	case 45:
+		if !hasURNPrefix(uuid) {
+			return nil, ErrInvalidFormat
 		}
-		return hex.DecodeString(string(result))
+		return decodeHexUUID(uuid[len(urnPrefix):], true)
 	default:
-		return nil, errors.New("invalid UUID length")
+		return nil, ErrInvalidLength
+	}
+}
+
+// hexOffsets holds the position of each byte's hex digits in a canonical UUID string.
+var hexOffsets = [16]int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
+
+// Helper function to decode the hex digits of a compact or canonical UUID in a single pass.
+func decodeHexUUID(s string, dashed bool) ([]byte, error) {
+	if dashed && (s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-') {
+		return nil, ErrInvalidFormat
+	}
+
+	uuid := make([]byte, 16)
+	for i := range uuid {
+		j := i * 2
+		if dashed {
+			j = hexOffsets[i]
+		}
+
+		hi, lo := hexValues[s[j]], hexValues[s[j+1]]
+		if hi == 0xFF || lo == 0xFF {
+			return nil, fmt.Errorf("%w: invalid hex characters %q", ErrInvalidFormat, s[j:j+2])
+		}
+		uuid[i] = hi<<4 | lo
+	}
+	return uuid, nil
+}
+
+// Helper function to check if a string starts with the URN prefix, ignoring case.
+func hasURNPrefix(s string) bool {
+	return len(s) >= len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix)
+}
+
+// Helper function to parse a UUID string and ensure it carries the UUIDv8 version and RFC4122 variant bits.
+func parseUUIDv8(uuid string) ([]byte, error) {
+	return parseUUIDWithVariant(uuid, variantRFC4122)
+}
+
+// Helper function to parse a UUID string and ensure it carries the UUIDv8 version and the given variant bits.
+func parseUUIDWithVariant(uuid string, variant byte) ([]byte, error) {
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUUIDv8Bytes(uuidBytes, variant); err != nil {
+		return nil, err
+	}
+	return uuidBytes, nil
+}
+
+// Helper function to ensure a UUID byte array is not all zero and carries the UUIDv8 version and the given variant bits.
+func validateUUIDv8Bytes(uuidBytes []byte, variant byte) error {
+	if isAllZeroUUID(uuidBytes) {
+		return ErrNilUUID
+	}
+	if version := uuidBytes[6] >> 4; version != versionV8 {
+		return fmt.Errorf("%w: got version %d", ErrWrongVersion, version)
 	}
+	if actual := (uuidBytes[7] >> 6) & 0x03; actual != variant {
+		return fmt.Errorf("%w: got variant %02b, expected %02b", ErrWrongVariant, actual, variant)
+	}
+	return nil
 }
 
 // Helper function to check if a UUID is all zeros.
@@ -66,7 +296,24 @@ func isAllZeroUUID(uuidBytes []byte) bool {
 	return true
 }
 
-// Helper function to format a UUID byte array as a string.
-func formatUUID(uuid []byte) string {
-	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+// Helper function to format a UUID byte array as a string with lowercase or uppercase hex digits.
+func formatUUID(uuid []byte, upper bool) string {
+	var buf [36]byte
+	return string(appendFormattedUUID(buf[:0], uuid, upper))
+}
+
+// Helper function to append the hyphenated hex form of a UUID byte array to dst.
+func appendFormattedUUID(dst []byte, uuid []byte, upper bool) []byte {
+	digits := "0123456789abcdef"
+	if upper {
+		digits = "0123456789ABCDEF"
+	}
+
+	for i, b := range uuid {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			dst = append(dst, '-')
+		}
+		dst = append(dst, digits[b>>4], digits[b&0x0F])
+	}
+	return dst
 }
@@ -0,0 +1,80 @@
+package uuidv8
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// legacyParseUUID is the previous two-pass implementation of parseUUID, kept for benchmarking.
+func legacyParseUUID(uuid string) ([]byte, error) {
+	switch len(uuid) {
+	case 32:
+		return hex.DecodeString(uuid)
+	case 36:
+		if uuid[8] != '-' || uuid[13] != '-' || uuid[18] != '-' || uuid[23] != '-' {
+			return nil, errors.New("invalid UUID format")
+		}
+
+		result := make([]byte, 32)
+		j := 0
+		for i := 0; i < len(uuid); i++ {
+			if uuid[i] != '-' {
+				result[j] = uuid[i]
+				j++
+			}
+		}
+		return hex.DecodeString(string(result))
+	default:
+		return nil, errors.New("invalid UUID length")
+	}
+}
+
+func TestParseUUID_MatchesLegacy(t *testing.T) {
+	inputs := []string{
+		"9a3d4049-0e2c-8080-0102-030405060000",
+		"9A3D4049-0E2C-8080-0102-030405060000",
+		"9a3d40490e2c80800102030405060000",
+		"123e4567-e89b-12d3-a456-42-6614174000",
+		"123e4567e89b12d3a45642661417400g",
+		"------------------------------------",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			expected, expectedErr := legacyParseUUID(input)
+			actual, err := parseUUID(input)
+			if (err != nil) != (expectedErr != nil) {
+				t.Fatalf("Error mismatch: legacy %v, new %v", expectedErr, err)
+			}
+			if err == nil && hex.EncodeToString(actual) != hex.EncodeToString(expected) {
+				t.Errorf("Byte mismatch: legacy %x, new %x", expected, actual)
+			}
+		})
+	}
+}
+
+func BenchmarkParseUUID(b *testing.B) {
+	inputs := []struct {
+		name string
+		uuid string
+	}{
+		{"Canonical", "9a3d4049-0e2c-8080-0102-030405060000"},
+		{"Compact", "9a3d40490e2c80800102030405060000"},
+	}
+
+	for _, input := range inputs {
+		b.Run(input.name+"/Legacy", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = legacyParseUUID(input.uuid)
+			}
+		})
+		b.Run(input.name+"/SinglePass", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = parseUUID(input.uuid)
+			}
+		})
+	}
+}
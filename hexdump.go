@@ -0,0 +1,63 @@
+package uuidv8
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HexDump returns an annotated, human-readable dump of a UUID's byte layout.
+//
+// Each line shows a group of raw bytes in hex followed by the field they encode and its decoded
+// value, which helps debugging incorrect encodings. Any well-formed UUID is accepted, so the
+// version and variant are reported as found rather than validated. The clock sequence excludes
+// the variant bits sharing its bytes. Example:
+//
+//	9A 3D 40 49 0E 2C | timestamp (48-bit): 169587862212140
+//	80 80             | version: 8, variant: RFC4122, clockSeq: 0
+//	01 02 03 04 05 06 | node: 01:02:03:04:05:06
+//	00 00             | unused
+//
+// Parameters:
+// - uuid: A string representation of a UUID.
+//
+// Returns:
+// - The multi-line dump, terminated by a newline.
+// - An error if the UUID cannot be parsed.
+func HexDump(uuid string) (string, error) {
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse UUID: %w", err)
+	}
+
+	u := decodeUUIDv8(uuidBytes)
+	version := uuidBytes[6] >> 4
+	variant := (uuidBytes[7] >> 6) & 0x03
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-17s | timestamp (48-bit): %d\n", hexBytes(uuidBytes[0:6], " "), u.Timestamp)
+	fmt.Fprintf(&sb, "%-17s | version: %d, variant: %s, clockSeq: %d\n", hexBytes(uuidBytes[6:8], " "), version, variantName(variant), u.ClockSeq&clockSeqMask)
+	fmt.Fprintf(&sb, "%-17s | node: %s\n", hexBytes(uuidBytes[8:14], " "), hexBytes(uuidBytes[8:14], ":"))
+	fmt.Fprintf(&sb, "%-17s | unused\n", hexBytes(uuidBytes[14:16], " "))
+	return sb.String(), nil
+}
+
+// Helper function to format bytes as uppercase hex pairs joined by sep.
+func hexBytes(b []byte, sep string) string {
+	pairs := make([]string, len(b))
+	for i, v := range b {
+		pairs[i] = fmt.Sprintf("%02X", v)
+	}
+	return strings.Join(pairs, sep)
+}
+
+// Helper function to name the 2-bit variant value stored in the UUID.
+func variantName(variant byte) string {
+	switch variant {
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	default:
+		return "NCS"
+	}
+}
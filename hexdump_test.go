@@ -0,0 +1,48 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestHexDump(t *testing.T) {
+	tests := []struct {
+		uuid        string
+		expected    string
+		description string
+	}{
+		{
+			"9a3d4049-0e2c-8080-0102-030405060000",
+			"9A 3D 40 49 0E 2C | timestamp (48-bit): 169587862212140\n" +
+				"80 80             | version: 8, variant: RFC4122, clockSeq: 0\n" +
+				"01 02 03 04 05 06 | node: 01:02:03:04:05:06\n" +
+				"00 00             | unused\n",
+			"Valid UUIDv8",
+		},
+		{
+			"0193bde4-a9fa-77eb-a304-6cf8530ece78",
+			"01 93 BD E4 A9 FA | timestamp (48-bit): 1734057699834\n" +
+				"77 EB             | version: 7, variant: Microsoft, clockSeq: 1835\n" +
+				"A3 04 6C F8 53 0E | node: A3:04:6C:F8:53:0E\n" +
+				"CE 78             | unused\n",
+			"UUIDv7 is dumped as found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dump, err := uuidv8.HexDump(test.uuid)
+			if err != nil {
+				t.Fatalf("HexDump failed: %v", err)
+			}
+			if dump != test.expected {
+				t.Errorf("Unexpected dump:\n%s\nexpected:\n%s", dump, test.expected)
+			}
+		})
+	}
+
+	if _, err := uuidv8.HexDump("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
@@ -0,0 +1,86 @@
+package uuidv8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strings"
+)
+
+// NewWithHostPort generates a UUIDv8 whose node identifies a network service by host and port.
+//
+// The node holds the FNV-1a 32-bit hash of the host in its first 4 bytes and the port in the
+// last 2 bytes (big-endian), so all UUIDs generated for the same host:port share a node. IP
+// addresses are normalized (e.g. "[::1]" and "0:0:0:0:0:0:0:1" hash identically) and host names
+// are compared case-insensitively. The timestamp is the current time in nanoseconds and the
+// clock sequence is random.
+//
+// Parameters:
+// - host: A host name or IPv4/IPv6 address.
+// - port: The service port.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the host is empty or the random clock sequence cannot be generated.
+func NewWithHostPort(host string, port uint16) (string, error) {
+	if host == "" {
+		return "", fmt.Errorf("host must not be empty")
+	}
+
+	return newWithNode(hostPortNode(host, port))
+}
+
+// ExtractPort returns the port of a UUIDv8 generated by NewWithHostPort.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The port stored in the last 2 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractPort(uuid string) (uint16, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract port: %w", err)
+	}
+	return binary.BigEndian.Uint16(node[4:]), nil
+}
+
+// MatchesHostPort reports whether a UUIDv8 was generated by NewWithHostPort for the given host and port.
+//
+// Since the host is stored as a 32-bit hash, different hosts may collide; use this for routing
+// decisions, not for authentication.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+// - host: A host name or IPv4/IPv6 address.
+// - port: The service port.
+//
+// Returns:
+// - A boolean indicating whether the node matches host and port.
+func MatchesHostPort(uuid string, host string, port uint16) bool {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(node, hostPortNode(host, port))
+}
+
+// Helper function to build the 6-byte node for a host and port.
+func hostPortNode(host string, port uint16) []byte {
+	h := fnv.New32a()
+	h.Write([]byte(normalizeHost(host)))
+
+	node := h.Sum(make([]byte, 0, 6))
+	return binary.BigEndian.AppendUint16(node, port)
+}
+
+// Helper function to normalize a host so that equivalent spellings hash identically.
+func normalizeHost(host string) string {
+	if ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")); ip != nil {
+		return ip.String()
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,88 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithHostPort(t *testing.T) {
+	tests := []struct {
+		host        string
+		port        uint16
+		description string
+	}{
+		{"api.example.com", 443, "Host name"},
+		{"10.0.1.15", 8080, "IPv4 address"},
+		{"2001:db8::1", 9000, "IPv6 address"},
+		{"[2001:db8::1]", 9000, "Bracketed IPv6 address"},
+		{"localhost", 0, "Zero port"},
+		{"localhost", 65535, "Maximum port"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithHostPort(test.host, test.port)
+			if err != nil {
+				t.Fatalf("NewWithHostPort failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithHostPort generated an invalid UUIDv8: %s", uuid)
+			}
+
+			port, err := uuidv8.ExtractPort(uuid)
+			if err != nil {
+				t.Fatalf("ExtractPort failed: %v", err)
+			}
+			if port != test.port {
+				t.Errorf("Port mismatch: expected %d, got %d", test.port, port)
+			}
+
+			if !uuidv8.MatchesHostPort(uuid, test.host, test.port) {
+				t.Errorf("Expected UUID %s to match %s:%d", uuid, test.host, test.port)
+			}
+			if uuidv8.MatchesHostPort(uuid, test.host, test.port+1) {
+				t.Errorf("Expected UUID %s not to match a different port", uuid)
+			}
+			if uuidv8.MatchesHostPort(uuid, "other."+test.host, test.port) {
+				t.Errorf("Expected UUID %s not to match a different host", uuid)
+			}
+		})
+	}
+}
+
+func TestMatchesHostPort_Normalization(t *testing.T) {
+	tests := []struct {
+		host        string
+		equivalent  string
+		description string
+	}{
+		{"[::1]", "0:0:0:0:0:0:0:1", "IPv6 loopback spellings"},
+		{"2001:DB8::1", "2001:db8:0:0:0:0:0:1", "IPv6 case and zero compression"},
+		{"API.Example.com", "api.example.com", "Host name case"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithHostPort(test.host, 443)
+			if err != nil {
+				t.Fatalf("NewWithHostPort failed: %v", err)
+			}
+			if !uuidv8.MatchesHostPort(uuid, test.equivalent, 443) {
+				t.Errorf("Expected %s and %s to match", test.host, test.equivalent)
+			}
+		})
+	}
+}
+
+func TestNewWithHostPort_InvalidInputs(t *testing.T) {
+	if _, err := uuidv8.NewWithHostPort("", 443); err == nil {
+		t.Error("Expected error for empty host")
+	}
+	if _, err := uuidv8.ExtractPort("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+	if uuidv8.MatchesHostPort("invalid-uuid", "localhost", 443) {
+		t.Error("Expected invalid UUID not to match")
+	}
+}
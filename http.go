@@ -0,0 +1,108 @@
+package uuidv8
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTP headers carrying request-scoped UUIDs.
+const (
+	HeaderRequestID     = "X-Request-ID"
+	HeaderCorrelationID = "X-Correlation-ID"
+)
+
+// NewWithRequestID extracts or generates the request-scoped UUIDv8 for an HTTP request.
+//
+// The X-Request-ID header is used if it holds a valid UUIDv8, then the X-Correlation-ID header.
+// If neither does, a new UUIDv8 is generated with New. Header values may be in any form accepted
+// by FromString and are returned in the canonical lowercase form, so the ID echoed with
+// SetResponseRequestID is always formatted consistently.
+//
+// Parameters:
+// - r: The incoming HTTP request. May be nil, in which case a new UUIDv8 is always generated.
+//
+// Returns:
+// - The request UUIDv8 string.
+// - A boolean that is true if the UUID was newly generated rather than taken from a header.
+// - An error if a new UUIDv8 had to be generated and generation failed.
+func NewWithRequestID(r *http.Request) (string, bool, error) {
+	if r != nil {
+		for _, header := range []string{HeaderRequestID, HeaderCorrelationID} {
+			if uuidBytes, err := parseUUIDv8(r.Header.Get(header)); err == nil {
+				return formatUUID(uuidBytes, false), false, nil
+			}
+		}
+	}
+
+	uuid, err := New()
+	if err != nil {
+		return "", false, err
+	}
+	return uuid, true, nil
+}
+
+// SetResponseRequestID sets the X-Request-ID header of an HTTP response.
+//
+// Parameters:
+// - w: The HTTP response writer. The header must be set before the response is written.
+// - uuid: The request UUIDv8, typically obtained from NewWithRequestID.
+func SetResponseRequestID(w http.ResponseWriter, uuid string) {
+	w.Header().Set(HeaderRequestID, uuid)
+}
+
+// NewWithHTTPMethod generates a UUIDv8 identifying an API endpoint by HTTP method and path.
+//
+// The method is uppercased and the path lowercased with trailing slashes trimmed, then
+// "METHOD path" is hashed with SHA-256. Hash bytes 0-5 become the node and bytes 6-7 the clock
+// sequence, masked to the 10 bits that survive encoding. Requests to the same endpoint therefore
+// share node and clock sequence and differ only in the timestamp, which is the current time in
+// nanoseconds. Use MatchesEndpoint to verify an idempotency key.
+//
+// Parameters:
+// - method: The HTTP method, e.g. "POST".
+// - path: The request path, e.g. "/v1/orders".
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the method is empty.
+func NewWithHTTPMethod(method string, path string) (string, error) {
+	if method == "" {
+		return "", fmt.Errorf("HTTP method must not be empty")
+	}
+
+	node, clockSeq := endpointHash(method, path)
+	return NewWithParams(uint64(time.Now().UnixNano()), clockSeq, node, TimestampBits48)
+}
+
+// MatchesEndpoint reports whether a UUIDv8 was generated by NewWithHTTPMethod for the given method and path.
+//
+// Method and path are normalized the same way as in NewWithHTTPMethod.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+// - method: The HTTP method.
+// - path: The request path.
+//
+// Returns:
+// - A boolean indicating whether node and clock sequence match the endpoint.
+func MatchesEndpoint(uuid string, method, path string) bool {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return false
+	}
+
+	u := decodeUUIDv8(uuidBytes)
+	node, clockSeq := endpointHash(method, path)
+	return bytes.Equal(u.Node, node) && u.ClockSeq&clockSeqMask == clockSeq
+}
+
+// Helper function to derive the node and clock sequence of an HTTP endpoint.
+func endpointHash(method, path string) ([]byte, uint16) {
+	sum := sha256.Sum256([]byte(strings.ToUpper(method) + " " + strings.TrimRight(strings.ToLower(path), "/")))
+	return sum[:6], binary.BigEndian.Uint16(sum[6:8]) & clockSeqMask
+}
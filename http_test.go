@@ -0,0 +1,152 @@
+package uuidv8_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithRequestID(t *testing.T) {
+	requestID := "9a3d4049-0e2c-8080-0102-030405060000"
+	correlationID := "0000075b-cd15-8880-0102-030405060000"
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		expected      string
+		expectedFresh bool
+	}{
+		{"Request ID header", map[string]string{uuidv8.HeaderRequestID: requestID}, requestID, false},
+		{"Correlation ID header", map[string]string{uuidv8.HeaderCorrelationID: correlationID}, correlationID, false},
+		{"Request ID takes precedence", map[string]string{
+			uuidv8.HeaderRequestID:     requestID,
+			uuidv8.HeaderCorrelationID: correlationID,
+		}, requestID, false},
+		{"Invalid request ID falls back to correlation ID", map[string]string{
+			uuidv8.HeaderRequestID:     "not-a-uuid",
+			uuidv8.HeaderCorrelationID: correlationID,
+		}, correlationID, false},
+		{"Uppercase request ID is canonicalized", map[string]string{
+			uuidv8.HeaderRequestID: "9A3D4049-0E2C-8080-0102-030405060000",
+		}, requestID, false},
+		{"Braced correlation ID is canonicalized", map[string]string{
+			uuidv8.HeaderCorrelationID: "{" + correlationID + "}",
+		}, correlationID, false},
+		{"UUIDv7 request ID is ignored", map[string]string{
+			uuidv8.HeaderRequestID: "0193bde4-a9fa-77eb-a304-6cf8530ece78",
+		}, "", true},
+		{"No headers", nil, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for key, value := range test.headers {
+				r.Header.Set(key, value)
+			}
+
+			uuid, fresh, err := uuidv8.NewWithRequestID(r)
+			if err != nil {
+				t.Fatalf("NewWithRequestID failed: %v", err)
+			}
+			if fresh != test.expectedFresh {
+				t.Errorf("Expected fresh=%v, got %v", test.expectedFresh, fresh)
+			}
+			if test.expected != "" && uuid != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, uuid)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithRequestID returned an invalid UUIDv8: %s", uuid)
+			}
+		})
+	}
+}
+
+func TestNewWithRequestID_NilRequest(t *testing.T) {
+	uuid, fresh, err := uuidv8.NewWithRequestID(nil)
+	if err != nil {
+		t.Fatalf("NewWithRequestID failed: %v", err)
+	}
+	if !fresh || !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("Expected a freshly generated UUIDv8, got %s (fresh=%v)", uuid, fresh)
+	}
+}
+
+func TestSetResponseRequestID(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid, _, err := uuidv8.NewWithRequestID(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		uuidv8.SetResponseRequestID(w, uuid)
+	})
+
+	requestID := "9a3d4049-0e2c-8080-0102-030405060000"
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(uuidv8.HeaderRequestID, requestID)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	if got := w.Header().Get(uuidv8.HeaderRequestID); got != requestID {
+		t.Errorf("Expected response header %s, got %s", requestID, got)
+	}
+}
+
+func TestNewWithHTTPMethod(t *testing.T) {
+	uuid, err := uuidv8.NewWithHTTPMethod(http.MethodPost, "/v1/orders")
+	if err != nil {
+		t.Fatalf("NewWithHTTPMethod failed: %v", err)
+	}
+	if !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("NewWithHTTPMethod generated an invalid UUIDv8: %s", uuid)
+	}
+
+	tests := []struct {
+		method, path string
+		expected     bool
+		description  string
+	}{
+		{"POST", "/v1/orders", true, "Same endpoint"},
+		{"post", "/V1/Orders/", true, "Normalized method and path"},
+		{"GET", "/v1/orders", false, "Different method"},
+		{"POST", "/v1/orders/42", false, "Different path"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if got := uuidv8.MatchesEndpoint(uuid, test.method, test.path); got != test.expected {
+				t.Errorf("MatchesEndpoint(%s, %s) = %v, expected %v", test.method, test.path, got, test.expected)
+			}
+		})
+	}
+
+	if uuidv8.MatchesEndpoint("invalid-uuid", http.MethodPost, "/v1/orders") {
+		t.Error("MatchesEndpoint: expected false for invalid UUID")
+	}
+}
+
+func TestNewWithHTTPMethod_SharedEndpoint(t *testing.T) {
+	first, err := uuidv8.NewWithHTTPMethod("PUT", "/v1/items/")
+	if err != nil {
+		t.Fatalf("NewWithHTTPMethod failed: %v", err)
+	}
+	second, err := uuidv8.NewWithHTTPMethod("put", "/v1/items")
+	if err != nil {
+		t.Fatalf("NewWithHTTPMethod failed: %v", err)
+	}
+
+	// Clock sequence and node are derived from the endpoint; only the timestamp differs
+	if first[15:] != second[15:] {
+		t.Errorf("Expected UUIDs of the same endpoint to share clock sequence and node: %s, %s", first, second)
+	}
+}
+
+func TestNewWithHTTPMethod_EmptyMethod(t *testing.T) {
+	if _, err := uuidv8.NewWithHTTPMethod("", "/v1/orders"); err == nil {
+		t.Error("Expected error for empty method")
+	}
+}
@@ -0,0 +1,57 @@
+package uuidv8
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ErrInvalidFraction is returned by Interpolate when the fraction is outside [0.0, 1.0].
+var ErrInvalidFraction = errors.New("fraction must be between 0.0 and 1.0")
+
+// Interpolate returns the UUIDv8 located at the given fraction of the way from start to end.
+//
+// Both UUIDs are read as big-endian 128-bit integers and the result is computed as
+// start + fraction*(end-start), then re-stamped with the UUIDv8 version and variant bits. This
+// enables picking split points for range partitioning and uniform sampling of UUID key spaces.
+// Because the version and variant bits are overwritten, the result may differ slightly from the
+// exact arithmetic midpoint, and fraction 0.0 or 1.0 yields start or end respectively.
+//
+// Parameters:
+// - start: The UUIDv8 at fraction 0.0.
+// - end: The UUIDv8 at fraction 1.0. May be smaller than start.
+// - fraction: The position within the range, in [0.0, 1.0].
+//
+// Returns:
+// - A string representation of the interpolated UUIDv8.
+// - ErrInvalidFraction if fraction is outside [0.0, 1.0] or NaN, or an error if either UUID is not a valid UUIDv8.
+func Interpolate(start, end string, fraction float64) (string, error) {
+	if math.IsNaN(fraction) || fraction < 0 || fraction > 1 {
+		return "", fmt.Errorf("%w, got %v", ErrInvalidFraction, fraction)
+	}
+
+	startBytes, err := parseUUIDv8(start)
+	if err != nil {
+		return "", fmt.Errorf("invalid start UUID: %w", err)
+	}
+	endBytes, err := parseUUIDv8(end)
+	if err != nil {
+		return "", fmt.Errorf("invalid end UUID: %w", err)
+	}
+
+	startInt := new(big.Int).SetBytes(startBytes)
+	endInt := new(big.Int).SetBytes(endBytes)
+
+	// offset = fraction * (end - start), computed with enough precision for 128-bit values
+	diff := new(big.Float).SetPrec(256).SetInt(new(big.Int).Sub(endInt, startInt))
+	offset, _ := diff.Mul(diff, new(big.Float).SetPrec(256).SetFloat64(fraction)).Int(nil)
+
+	uuid := make([]byte, 16)
+	new(big.Int).Add(startInt, offset).FillBytes(uuid)
+
+	uuid[6] = (byte(versionV8) << 4) | (uuid[6] & 0x0F)
+	uuid[7] = (variantRFC4122 << 6) | (uuid[7] & 0x3F)
+
+	return formatUUID(uuid, false), nil
+}
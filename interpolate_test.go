@@ -0,0 +1,83 @@
+package uuidv8_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestInterpolate(t *testing.T) {
+	start := "00000000-0000-8080-0000-000000000000"
+	end := "ffffffff-ffff-8fbf-ffff-ffffffffffff"
+
+	tests := []struct {
+		fraction    float64
+		expected    string
+		description string
+	}{
+		{0, start, "Start of range"},
+		{1, end, "End of range"},
+		{0.5, "80000000-0000-889f-ffff-ffffffffffff", "Midpoint"},
+		{0.25, "40000000-0000-848f-ffff-ffffffffffff", "First quarter"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			result, err := uuidv8.Interpolate(start, end, test.fraction)
+			if err != nil {
+				t.Fatalf("Interpolate failed: %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+			if !uuidv8.IsValidUUIDv8(result) {
+				t.Errorf("Interpolate returned an invalid UUIDv8: %s", result)
+			}
+		})
+	}
+}
+
+func TestInterpolate_Ordering(t *testing.T) {
+	start := "0000075b-cd15-8880-0102-030405060000"
+	end := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	previous := start
+	for _, fraction := range []float64{0.1, 0.3, 0.5, 0.7, 0.9} {
+		result, err := uuidv8.Interpolate(start, end, fraction)
+		if err != nil {
+			t.Fatalf("Interpolate failed: %v", err)
+		}
+		if result <= previous || result >= end {
+			t.Errorf("Interpolated UUID %s at %v is not between %s and %s", result, fraction, previous, end)
+		}
+		previous = result
+	}
+
+	// A reversed range interpolates downwards
+	reversed, err := uuidv8.Interpolate(end, start, 0.5)
+	if err != nil {
+		t.Fatalf("Interpolate failed: %v", err)
+	}
+	if reversed <= start || reversed >= end {
+		t.Errorf("Interpolated UUID %s is not between %s and %s", reversed, start, end)
+	}
+}
+
+func TestInterpolate_ErrorCases(t *testing.T) {
+	valid := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	for _, fraction := range []float64{-0.1, 1.1, math.NaN(), math.Inf(1)} {
+		if _, err := uuidv8.Interpolate(valid, valid, fraction); !errors.Is(err, uuidv8.ErrInvalidFraction) {
+			t.Errorf("Expected ErrInvalidFraction for %v, got %v", fraction, err)
+		}
+	}
+
+	if _, err := uuidv8.Interpolate("invalid-uuid", valid, 0.5); err == nil {
+		t.Error("Expected error for invalid start UUID")
+	}
+	if _, err := uuidv8.Interpolate(valid, "invalid-uuid", 0.5); err == nil {
+		t.Error("Expected error for invalid end UUID")
+	}
+}
@@ -0,0 +1,62 @@
+package uuidv8
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// NewWithJitter generates a UUIDv8 whose timestamp is shifted forward by a random offset.
+//
+// The offset is drawn uniformly from [0, maxJitter) using crypto/rand. Spreading timestamps this
+// way avoids clusters of identical timestamps when many services start generating UUIDs at the
+// same moment (e.g. after a coordinated restart), which would otherwise create index hot spots.
+//
+// Parameters:
+// - maxJitter: The exclusive upper bound of the random offset. Must be positive.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if maxJitter is not positive or random data cannot be generated.
+func NewWithJitter(maxJitter time.Duration) (string, error) {
+	offset, err := randomJitter(maxJitter)
+	if err != nil {
+		return "", err
+	}
+
+	return newWithTimestamp(uint64(time.Now().UnixNano()) + offset)
+}
+
+// NewWithNegativeJitter generates a UUIDv8 whose timestamp is shifted backward by a random offset.
+//
+// This is the symmetric counterpart of NewWithJitter: the offset is drawn uniformly from
+// [0, maxJitter) and subtracted from the current time.
+//
+// Parameters:
+// - maxJitter: The exclusive upper bound of the random offset. Must be positive.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if maxJitter is not positive or random data cannot be generated.
+func NewWithNegativeJitter(maxJitter time.Duration) (string, error) {
+	offset, err := randomJitter(maxJitter)
+	if err != nil {
+		return "", err
+	}
+
+	return newWithTimestamp(uint64(time.Now().UnixNano()) - offset)
+}
+
+// Helper function to draw a uniformly distributed jitter in nanoseconds from [0, maxJitter).
+func randomJitter(maxJitter time.Duration) (uint64, error) {
+	if maxJitter <= 0 {
+		return 0, fmt.Errorf("max jitter must be positive, got %s", maxJitter)
+	}
+
+	offset, err := rand.Int(rand.Reader, big.NewInt(int64(maxJitter)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate random jitter: %w", err)
+	}
+	return offset.Uint64(), nil
+}
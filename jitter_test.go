@@ -0,0 +1,67 @@
+package uuidv8_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ash3in/uuidv8"
+)
+
+const timestampMask48 = 1<<48 - 1
+
+func TestNewWithJitter(t *testing.T) {
+	maxJitter := 10 * time.Millisecond
+
+	before := uint64(time.Now().UnixNano()) & timestampMask48
+	uuid, err := uuidv8.NewWithJitter(maxJitter)
+	if err != nil {
+		t.Fatalf("NewWithJitter failed: %v", err)
+	}
+	after := uint64(time.Now().UnixNano()) & timestampMask48
+
+	if !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("NewWithJitter generated an invalid UUIDv8: %s", uuid)
+	}
+
+	parsed, _ := uuidv8.FromString(uuid)
+	offset := (parsed.Timestamp - before) & timestampMask48
+	if offset > after-before+uint64(maxJitter) {
+		t.Errorf("Timestamp %d outside of jitter window starting at %d", parsed.Timestamp, before)
+	}
+}
+
+func TestNewWithNegativeJitter(t *testing.T) {
+	maxJitter := 10 * time.Millisecond
+
+	before := uint64(time.Now().UnixNano()) & timestampMask48
+	uuid, err := uuidv8.NewWithNegativeJitter(maxJitter)
+	if err != nil {
+		t.Fatalf("NewWithNegativeJitter failed: %v", err)
+	}
+	after := uint64(time.Now().UnixNano()) & timestampMask48
+
+	if !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("NewWithNegativeJitter generated an invalid UUIDv8: %s", uuid)
+	}
+
+	parsed, _ := uuidv8.FromString(uuid)
+	offset := (after - parsed.Timestamp) & timestampMask48
+	if offset > after-before+uint64(maxJitter) {
+		t.Errorf("Timestamp %d outside of jitter window ending at %d", parsed.Timestamp, after)
+	}
+}
+
+func TestNewWithJitter_InvalidJitter(t *testing.T) {
+	invalidJitters := []time.Duration{0, -time.Second}
+
+	for _, jitter := range invalidJitters {
+		t.Run("Invalid jitter "+jitter.String(), func(t *testing.T) {
+			if _, err := uuidv8.NewWithJitter(jitter); err == nil {
+				t.Errorf("NewWithJitter: expected error for jitter %s", jitter)
+			}
+			if _, err := uuidv8.NewWithNegativeJitter(jitter); err == nil {
+				t.Errorf("NewWithNegativeJitter: expected error for jitter %s", jitter)
+			}
+		})
+	}
+}
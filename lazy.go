@@ -0,0 +1,93 @@
+package uuidv8
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// LazyUUIDv8 defers generating a UUIDv8 until it is first used.
+//
+// Structs that carry an ID which may never be read (e.g. lazy-loaded events in ORM models) avoid
+// spending entropy and CPU on it up front. The zero value is ready to use and safe for concurrent
+// use; it must not be copied after first use.
+type LazyUUIDv8 struct {
+	mu   sync.Mutex
+	uuid string
+}
+
+// Get returns the UUIDv8, generating it with New on the first call.
+//
+// Returns:
+// - A string representation of the UUIDv8.
+// - An error if generation fails, in which case the next call tries again.
+func (l *LazyUUIDv8) Get() (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.uuid == "" {
+		uuid, err := New()
+		if err != nil {
+			return "", err
+		}
+		l.uuid = uuid
+	}
+	return l.uuid, nil
+}
+
+// IsGenerated reports whether the UUIDv8 has been generated or set by UnmarshalJSON.
+func (l *LazyUUIDv8) IsGenerated() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.uuid != ""
+}
+
+// Reset clears the cached UUIDv8 so that the next call to Get generates a new one.
+func (l *LazyUUIDv8) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.uuid = ""
+}
+
+// MarshalJSON serializes the UUIDv8 as a JSON string, generating it first if needed.
+//
+// Returns:
+// - A JSON-encoded byte slice of the UUID string.
+// - An error if generation fails.
+func (l *LazyUUIDv8) MarshalJSON() ([]byte, error) {
+	uuid, err := l.Get()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(uuid)
+}
+
+// UnmarshalJSON sets the UUIDv8 from a JSON string without generating a new one.
+//
+// A JSON null resets the value, so that the UUIDv8 is generated on first use.
+//
+// Parameters:
+// - data: A JSON-encoded byte slice containing the UUID string or null.
+//
+// Returns:
+// - An error if the data is not a JSON string holding a valid UUIDv8.
+func (l *LazyUUIDv8) UnmarshalJSON(data []byte) error {
+	var uuid *string
+	if err := json.Unmarshal(data, &uuid); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	if uuid != nil && !IsValidUUIDv8(*uuid) {
+		return fmt.Errorf("input is not a valid UUIDv8: %s", *uuid)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.uuid = ""
+	if uuid != nil {
+		l.uuid = *uuid
+	}
+	return nil
+}
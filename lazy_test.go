@@ -0,0 +1,110 @@
+package uuidv8_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestLazyUUIDv8_Get(t *testing.T) {
+	var lazy uuidv8.LazyUUIDv8
+	if lazy.IsGenerated() {
+		t.Fatal("Zero value should not be generated")
+	}
+
+	first, err := lazy.Get()
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !uuidv8.IsValidUUIDv8(first) {
+		t.Errorf("Get returned an invalid UUIDv8: %s", first)
+	}
+	if !lazy.IsGenerated() {
+		t.Error("Expected IsGenerated after Get")
+	}
+
+	second, _ := lazy.Get()
+	if first != second {
+		t.Errorf("Get should return the cached UUID: %s, %s", first, second)
+	}
+
+	lazy.Reset()
+	if lazy.IsGenerated() {
+		t.Error("Expected IsGenerated to be false after Reset")
+	}
+	third, _ := lazy.Get()
+	if third == first {
+		t.Errorf("Expected a new UUID after Reset, got %s again", third)
+	}
+}
+
+func TestLazyUUIDv8_Concurrent(t *testing.T) {
+	var lazy uuidv8.LazyUUIDv8
+	var wg sync.WaitGroup
+	results := make([]string, 50)
+
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = lazy.Get()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, uuid := range results {
+		if uuid != results[0] {
+			t.Fatalf("Concurrent Get calls returned different UUIDs: %s, %s", results[0], uuid)
+		}
+	}
+}
+
+func TestLazyUUIDv8_JSON(t *testing.T) {
+	type event struct {
+		ID *uuidv8.LazyUUIDv8 `json:"id"`
+	}
+
+	e := event{ID: &uuidv8.LazyUUIDv8{}}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if !e.ID.IsGenerated() {
+		t.Error("Expected MarshalJSON to generate the UUID")
+	}
+
+	var decoded event
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !decoded.ID.IsGenerated() {
+		t.Fatal("Expected UnmarshalJSON to populate the UUID")
+	}
+
+	original, _ := e.ID.Get()
+	restored, _ := decoded.ID.Get()
+	if original != restored {
+		t.Errorf("Round-trip mismatch: expected %s, got %s", original, restored)
+	}
+}
+
+func TestLazyUUIDv8_UnmarshalJSON_EdgeCases(t *testing.T) {
+	var lazy uuidv8.LazyUUIDv8
+	if _, err := lazy.Get(); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if err := lazy.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatalf("UnmarshalJSON(null) failed: %v", err)
+	}
+	if lazy.IsGenerated() {
+		t.Error("Expected null to reset the UUID")
+	}
+
+	for _, input := range []string{`"invalid-uuid"`, `"00000000-0000-0000-0000-000000000000"`, `12345`, `{`} {
+		if err := lazy.UnmarshalJSON([]byte(input)); err == nil {
+			t.Errorf("Expected error for input %s", input)
+		}
+	}
+}
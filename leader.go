@@ -0,0 +1,60 @@
+package uuidv8
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// NewWithLeaderElection generates a UUIDv8 identifying an event by its consensus term and leader.
+//
+// The node holds the term in its first 4 bytes and the leader ID in the last 2 bytes (both
+// big-endian), so all UUIDs generated by the same leader during the same term share a node and
+// can be filtered per term without parsing the event payload. This suits Raft-like protocols.
+// The timestamp is the current time in nanoseconds and the clock sequence is random.
+//
+// Parameters:
+// - leaderID: The identifier of the current leader.
+// - termID: The current election term.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the random clock sequence cannot be generated.
+func NewWithLeaderElection(leaderID uint16, termID uint32) (string, error) {
+	node := make([]byte, 6)
+	binary.BigEndian.PutUint32(node[:4], termID)
+	binary.BigEndian.PutUint16(node[4:], leaderID)
+
+	return newWithNode(node)
+}
+
+// ExtractTerm returns the election term of a UUIDv8 generated by NewWithLeaderElection.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The term stored in the first 4 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractTerm(uuid string) (uint32, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract term: %w", err)
+	}
+	return binary.BigEndian.Uint32(node[:4]), nil
+}
+
+// ExtractLeader returns the leader ID of a UUIDv8 generated by NewWithLeaderElection.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The leader ID stored in the last 2 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractLeader(uuid string) (uint16, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract leader: %w", err)
+	}
+	return binary.BigEndian.Uint16(node[4:]), nil
+}
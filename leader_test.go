@@ -0,0 +1,66 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithLeaderElection(t *testing.T) {
+	tests := []struct {
+		leaderID    uint16
+		termID      uint32
+		description string
+	}{
+		{1, 1, "First term"},
+		{0xABCD, 0x12345678, "Arbitrary values"},
+		{0xFFFF, 0xFFFFFFFF, "Maximum values"},
+		{0, 0, "Zero values"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithLeaderElection(test.leaderID, test.termID)
+			if err != nil {
+				t.Fatalf("NewWithLeaderElection failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithLeaderElection generated an invalid UUIDv8: %s", uuid)
+			}
+
+			term, err := uuidv8.ExtractTerm(uuid)
+			if err != nil {
+				t.Fatalf("ExtractTerm failed: %v", err)
+			}
+			if term != test.termID {
+				t.Errorf("Term mismatch: expected %d, got %d", test.termID, term)
+			}
+
+			leader, err := uuidv8.ExtractLeader(uuid)
+			if err != nil {
+				t.Fatalf("ExtractLeader failed: %v", err)
+			}
+			if leader != test.leaderID {
+				t.Errorf("Leader mismatch: expected %d, got %d", test.leaderID, leader)
+			}
+		})
+	}
+}
+
+func TestNewWithLeaderElection_SharedNode(t *testing.T) {
+	first, _ := uuidv8.NewWithLeaderElection(7, 42)
+	second, _ := uuidv8.NewWithLeaderElection(7, 42)
+
+	if first[19:] != second[19:] {
+		t.Errorf("Expected UUIDs of the same term and leader to share a node: %s, %s", first, second)
+	}
+}
+
+func TestExtractLeaderElection_InvalidUUID(t *testing.T) {
+	if _, err := uuidv8.ExtractTerm("invalid-uuid"); err == nil {
+		t.Error("ExtractTerm: expected error for invalid UUID")
+	}
+	if _, err := uuidv8.ExtractLeader("invalid-uuid"); err == nil {
+		t.Error("ExtractLeader: expected error for invalid UUID")
+	}
+}
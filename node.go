@@ -0,0 +1,161 @@
+package uuidv8
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+)
+
+// NodeDecoder decodes the node of a UUIDv8 whose first node byte carries a given node version.
+type NodeDecoder interface {
+	// Decode interprets the 6-byte node, including the leading version byte.
+	Decode(node []byte) (interface{}, error)
+}
+
+var (
+	nodeDecodersMu sync.RWMutex
+	nodeDecoders   = map[uint8]NodeDecoder{}
+)
+
+// NewWithVersionedNode generates a UUIDv8 whose node starts with a node version byte.
+//
+// The node is laid out as [nodeVersion, serviceID_hi, serviceID_lo, r1, r2, r3], where nodeVersion
+// identifies the node encoding scheme so that parsers can dispatch on it as layouts evolve
+// (see RegisterNodeDecoder and DecodeNode). The timestamp is the current time in nanoseconds and
+// the clock sequence is random.
+//
+// Parameters:
+// - nodeVersion: Identifier of the node encoding scheme.
+// - serviceID: Identifier of the service generating the UUID.
+// - random: Whether to fill the last 3 node bytes with random data. If false, they are zero.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if random data cannot be generated.
+func NewWithVersionedNode(nodeVersion uint8, serviceID uint16, random bool) (string, error) {
+	node := []byte{nodeVersion, byte(serviceID >> 8), byte(serviceID), 0, 0, 0}
+	if random {
+		if _, err := rand.Read(node[3:]); err != nil {
+			return "", fmt.Errorf("failed to generate random node: %w", err)
+		}
+	}
+
+	return newWithNode(node)
+}
+
+// ExtractNodeVersion returns the node version byte of a UUIDv8 generated by NewWithVersionedNode.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The first byte of the node.
+// - An error if the input is not a valid UUIDv8.
+func ExtractNodeVersion(uuid string) (uint8, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract node version: %w", err)
+	}
+	return node[0], nil
+}
+
+// RegisterNodeDecoder registers the decoder used by DecodeNode for the given node version.
+//
+// Parameters:
+// - version: The node version handled by the decoder.
+// - d: The decoder.
+//
+// Returns:
+// - An error if d is nil or a decoder is already registered for the version.
+func RegisterNodeDecoder(version uint8, d NodeDecoder) error {
+	if d == nil {
+		return errors.New("node decoder must not be nil")
+	}
+
+	nodeDecodersMu.Lock()
+	defer nodeDecodersMu.Unlock()
+
+	if _, exists := nodeDecoders[version]; exists {
+		return fmt.Errorf("a node decoder is already registered for node version %d", version)
+	}
+	nodeDecoders[version] = d
+	return nil
+}
+
+// Helper function to remove a decoder registered with RegisterNodeDecoder, used to keep tests independent.
+func unregisterNodeDecoder(version uint8) {
+	nodeDecodersMu.Lock()
+	defer nodeDecodersMu.Unlock()
+
+	delete(nodeDecoders, version)
+}
+
+// DecodeNode decodes the node of a UUIDv8 with the decoder registered for its node version.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The value produced by the registered NodeDecoder.
+// - An error if the input is invalid, no decoder is registered for its node version, or decoding fails.
+func DecodeNode(uuid string) (interface{}, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode node: %w", err)
+	}
+
+	version := node[0]
+
+	nodeDecodersMu.RLock()
+	d, ok := nodeDecoders[version]
+	nodeDecodersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no node decoder registered for node version %d", version)
+	}
+	return d.Decode(node)
+}
+
+// NodeFromInterface returns the MAC address of a network interface as a node.
+//
+// Parameters:
+// - name: The interface name, e.g. "eth0".
+//
+// Returns:
+// - A 6-byte node ready to pass to NewWithParams.
+// - An error if the interface does not exist or has no 6-byte hardware address.
+func NodeFromInterface(name string) ([]byte, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up interface %q: %w", name, err)
+	}
+	if len(iface.HardwareAddr) != 6 {
+		return nil, fmt.Errorf("interface %q has no 6-byte hardware address", name)
+	}
+	return append([]byte(nil), iface.HardwareAddr...), nil
+}
+
+// NodeFromAnyInterface returns the MAC address of the first suitable network interface as a node.
+//
+// Loopback interfaces and interfaces without a 6-byte hardware address are skipped. Unlike New,
+// it never falls back to random bytes.
+//
+// Returns:
+// - A 6-byte node ready to pass to NewWithParams.
+// - An error if the interfaces cannot be listed or none of them is suitable.
+func NodeFromAnyInterface() ([]byte, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interfaces: %w", err)
+	}
+
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 || len(iface.HardwareAddr) != 6 {
+			continue
+		}
+		return append([]byte(nil), iface.HardwareAddr...), nil
+	}
+	return nil, errors.New("no network interface with a 6-byte hardware address found")
+}
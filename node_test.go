@@ -0,0 +1,178 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+type serviceNode struct {
+	ServiceID uint16
+}
+
+type serviceNodeDecoder struct{}
+
+func (serviceNodeDecoder) Decode(node []byte) (interface{}, error) {
+	if len(node) != 6 {
+		return nil, errors.New("unexpected node length")
+	}
+	return serviceNode{ServiceID: uint16(node[1])<<8 | uint16(node[2])}, nil
+}
+
+func TestNewWithVersionedNode(t *testing.T) {
+	tests := []struct {
+		nodeVersion uint8
+		serviceID   uint16
+		random      bool
+		description string
+	}{
+		{1, 0x1234, true, "Random node suffix"},
+		{2, 0xFFFF, false, "Zero node suffix"},
+		{0, 0, false, "Zero values"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithVersionedNode(test.nodeVersion, test.serviceID, test.random)
+			if err != nil {
+				t.Fatalf("NewWithVersionedNode failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithVersionedNode generated an invalid UUIDv8: %s", uuid)
+			}
+
+			version, err := uuidv8.ExtractNodeVersion(uuid)
+			if err != nil {
+				t.Fatalf("ExtractNodeVersion failed: %v", err)
+			}
+			if version != test.nodeVersion {
+				t.Errorf("Node version mismatch: expected %d, got %d", test.nodeVersion, version)
+			}
+
+			parsed, _ := uuidv8.FromString(uuid)
+			if serviceID := uint16(parsed.Node[1])<<8 | uint16(parsed.Node[2]); serviceID != test.serviceID {
+				t.Errorf("Service ID mismatch: expected %#x, got %#x", test.serviceID, serviceID)
+			}
+			if !test.random && (parsed.Node[3] != 0 || parsed.Node[4] != 0 || parsed.Node[5] != 0) {
+				t.Errorf("Expected zero node suffix, got %x", parsed.Node[3:])
+			}
+		})
+	}
+}
+
+func TestExtractNodeVersion_InvalidUUID(t *testing.T) {
+	if _, err := uuidv8.ExtractNodeVersion("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
+
+func TestDecodeNode(t *testing.T) {
+	const nodeVersion = 42
+
+	if err := uuidv8.RegisterNodeDecoder(nodeVersion, serviceNodeDecoder{}); err != nil {
+		t.Fatalf("RegisterNodeDecoder failed: %v", err)
+	}
+	t.Cleanup(func() { uuidv8.UnregisterNodeDecoder(nodeVersion) })
+
+	uuid, err := uuidv8.NewWithVersionedNode(nodeVersion, 0xBEEF, true)
+	if err != nil {
+		t.Fatalf("NewWithVersionedNode failed: %v", err)
+	}
+
+	decoded, err := uuidv8.DecodeNode(uuid)
+	if err != nil {
+		t.Fatalf("DecodeNode failed: %v", err)
+	}
+	if decoded != (serviceNode{ServiceID: 0xBEEF}) {
+		t.Errorf("Unexpected decoded node: %+v", decoded)
+	}
+
+	t.Run("Duplicate registration", func(t *testing.T) {
+		if err := uuidv8.RegisterNodeDecoder(nodeVersion, serviceNodeDecoder{}); err == nil {
+			t.Error("Expected error when registering a decoder twice")
+		}
+	})
+
+	t.Run("Nil decoder", func(t *testing.T) {
+		if err := uuidv8.RegisterNodeDecoder(nodeVersion+1, nil); err == nil {
+			t.Error("Expected error when registering a nil decoder")
+		}
+	})
+
+	t.Run("Unregistered node version", func(t *testing.T) {
+		unregistered, _ := uuidv8.NewWithVersionedNode(nodeVersion+2, 0, false)
+		if _, err := uuidv8.DecodeNode(unregistered); err == nil {
+			t.Error("Expected error for unregistered node version")
+		}
+	})
+
+	t.Run("Invalid UUID", func(t *testing.T) {
+		if _, err := uuidv8.DecodeNode("invalid-uuid"); err == nil {
+			t.Error("Expected error for invalid UUID")
+		}
+	})
+}
+
+func TestNodeFromInterface(t *testing.T) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		t.Skipf("Cannot list interfaces: %v", err)
+	}
+
+	for _, iface := range ifaces {
+		node, err := uuidv8.NodeFromInterface(iface.Name)
+		if len(iface.HardwareAddr) != 6 {
+			if err == nil {
+				t.Errorf("Expected error for interface %s without a 6-byte hardware address", iface.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("NodeFromInterface(%s) failed: %v", iface.Name, err)
+		}
+		if !bytes.Equal(node, iface.HardwareAddr) {
+			t.Errorf("Expected node %x, got %x", []byte(iface.HardwareAddr), node)
+		}
+		if _, err := uuidv8.NewWithParams(1, 0, node, uuidv8.TimestampBits48); err != nil {
+			t.Errorf("Node from interface %s cannot be used with NewWithParams: %v", iface.Name, err)
+		}
+	}
+
+	if _, err := uuidv8.NodeFromInterface("does-not-exist0"); err == nil {
+		t.Error("Expected error for unknown interface")
+	}
+}
+
+func TestNodeFromAnyInterface(t *testing.T) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		t.Skipf("Cannot list interfaces: %v", err)
+	}
+
+	var expected net.HardwareAddr
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback == 0 && len(iface.HardwareAddr) == 6 {
+			expected = iface.HardwareAddr
+			break
+		}
+	}
+
+	node, err := uuidv8.NodeFromAnyInterface()
+	if expected == nil {
+		if err == nil {
+			t.Errorf("Expected error without a suitable interface, got node %x", node)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("NodeFromAnyInterface failed: %v", err)
+	}
+	if !bytes.Equal(node, expected) {
+		t.Errorf("Expected node %x, got %x", []byte(expected), node)
+	}
+}
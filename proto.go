@@ -0,0 +1,67 @@
+package uuidv8
+
+import "fmt"
+
+// ToProtoBytes returns the 16-byte binary representation of the UUIDv8 for a protobuf `bytes` field.
+//
+// A `bytes` field carries the UUID in 16 bytes (plus tag and length) instead of the 36 characters
+// of a `string` field, and avoids formatting and parsing hex on both ends. Note that the protobuf
+// JSON mapping encodes `bytes` fields as standard base64 (24 characters), so the saving shrinks
+// and the value is no longer human-readable when messages are exchanged as JSON.
+//
+// Returns:
+// - The 16 raw UUID bytes, or nil for a nil UUIDv8.
+func (u *UUIDv8) ToProtoBytes() []byte {
+	if u == nil {
+		return nil
+	}
+	return uuidv8Bytes(u)
+}
+
+// FromProtoBytes parses the value of a protobuf `bytes` field produced by ToProtoBytes.
+//
+// Parameters:
+// - b: The 16 raw UUID bytes.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if b is not 16 bytes, is all zero, or does not carry the UUIDv8 version and variant bits.
+func FromProtoBytes(b []byte) (*UUIDv8, error) {
+	if len(b) != 16 {
+		return nil, fmt.Errorf("failed to parse proto bytes: UUID must be 16 bytes, got %d bytes", len(b))
+	}
+	if err := validateUUIDv8Bytes(b, variantRFC4122); err != nil {
+		return nil, fmt.Errorf("failed to parse proto bytes: %w", err)
+	}
+	return decodeUUIDv8(append([]byte(nil), b...)), nil
+}
+
+// ToProtoString returns the canonical string representation of the UUIDv8 for a protobuf `string` field.
+//
+// A `string` field is 36 bytes on the wire but stays readable in logs and in the protobuf JSON
+// mapping; prefer ToProtoBytes when message size matters.
+//
+// Returns:
+// - The canonical UUID string, or an empty string for a nil UUIDv8.
+func (u *UUIDv8) ToProtoString() string {
+	if u == nil {
+		return ""
+	}
+	return ToString(u)
+}
+
+// FromProtoString parses the value of a protobuf `string` field produced by ToProtoString.
+//
+// Parameters:
+// - s: A string representation of a UUIDv8.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if s is not a valid UUIDv8.
+func FromProtoString(s string) (*UUIDv8, error) {
+	uuidBytes, err := parseUUIDv8(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proto string: %w", err)
+	}
+	return decodeUUIDv8(uuidBytes), nil
+}
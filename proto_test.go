@@ -0,0 +1,84 @@
+package uuidv8_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestProtoBytes(t *testing.T) {
+	uuid, err := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	b := uuid.ToProtoBytes()
+	if len(b) != 16 {
+		t.Fatalf("Expected 16 bytes, got %d", len(b))
+	}
+
+	parsed, err := uuidv8.FromProtoBytes(b)
+	if err != nil {
+		t.Fatalf("FromProtoBytes failed: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, uuid) {
+		t.Errorf("Round-trip mismatch: expected %+v, got %+v", uuid, parsed)
+	}
+
+	// The parsed UUID must not alias the input buffer
+	clear(b)
+	if !reflect.DeepEqual(parsed, uuid) {
+		t.Errorf("Parsed UUID changed after the input buffer was cleared: %+v", parsed)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if b := nilUUID.ToProtoBytes(); b != nil {
+		t.Errorf("Expected nil bytes for nil UUIDv8, got %x", b)
+	}
+}
+
+func TestFromProtoBytes_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		input       []byte
+		description string
+	}{
+		{nil, "Nil slice"},
+		{make([]byte, 15), "Too short"},
+		{make([]byte, 17), "Too long"},
+		{make([]byte, 16), "All zero"},
+		{[]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x40, 0x80, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0, 0}, "Wrong version"},
+		{[]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x80, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0, 0}, "Wrong variant"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.FromProtoBytes(test.input); err == nil {
+				t.Errorf("Expected error for input %x", test.input)
+			}
+		})
+	}
+}
+
+func TestProtoString(t *testing.T) {
+	const input = "9a3d4049-0e2c-8080-0102-030405060000"
+
+	uuid, err := uuidv8.FromProtoString(input)
+	if err != nil {
+		t.Fatalf("FromProtoString failed: %v", err)
+	}
+	if s := uuid.ToProtoString(); s != input {
+		t.Errorf("Expected %s, got %s", input, s)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if s := nilUUID.ToProtoString(); s != "" {
+		t.Errorf("Expected empty string for nil UUIDv8, got %s", s)
+	}
+
+	for _, input := range []string{"", "invalid-uuid", "00000000-0000-0000-0000-000000000000", "9a3d4049-0e2c-4080-0102-030405060000"} {
+		if _, err := uuidv8.FromProtoString(input); err == nil {
+			t.Errorf("Expected error for input %q", input)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package uuidv8
+
+import "fmt"
+
+// maxSequenceNumber is the exclusive upper bound of sequence numbers that fit the 48-bit timestamp field.
+const maxSequenceNumber = 1 << 48
+
+// NewWithSequenceNumber generates a UUIDv8 that carries a message sequence number in place of the timestamp.
+//
+// Message queues and event buses assign monotonically increasing sequence numbers; storing the
+// sequence number in the 48-bit timestamp field makes the UUIDs sort in sequence order rather
+// than wall-clock order. The clock sequence is random.
+//
+// Parameters:
+// - seqNum: The sequence number. Must be less than 2^48.
+// - node: A 6-byte slice representing a unique identifier.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if seqNum does not fit in 48 bits, the node is invalid, or the random clock sequence cannot be generated.
+func NewWithSequenceNumber(seqNum uint64, node []byte) (string, error) {
+	if seqNum >= maxSequenceNumber {
+		return "", fmt.Errorf("sequence number %d exceeds 48 bits", seqNum)
+	}
+
+	clockSeq, err := randomClockSeq()
+	if err != nil {
+		return "", err
+	}
+
+	return NewWithParams(seqNum, clockSeq, node, TimestampBits48)
+}
+
+// ExtractSequenceNumber returns the sequence number of a UUIDv8 generated by NewWithSequenceNumber.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The sequence number stored in the timestamp field.
+// - An error if the input is not a valid UUIDv8.
+func ExtractSequenceNumber(uuid string) (uint64, error) {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract sequence number: %w", err)
+	}
+	return decodeTimestamp(uuidBytes[:6]), nil
+}
@@ -0,0 +1,71 @@
+package uuidv8_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithSequenceNumber(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	for _, seqNum := range []uint64{0, 1, 42, 1<<32 + 7, 1<<48 - 1} {
+		uuid, err := uuidv8.NewWithSequenceNumber(seqNum, node)
+		if err != nil {
+			t.Fatalf("NewWithSequenceNumber(%d) failed: %v", seqNum, err)
+		}
+		if !uuidv8.IsValidUUIDv8(uuid) {
+			t.Errorf("NewWithSequenceNumber generated an invalid UUIDv8: %s", uuid)
+		}
+
+		extracted, err := uuidv8.ExtractSequenceNumber(uuid)
+		if err != nil {
+			t.Fatalf("ExtractSequenceNumber failed: %v", err)
+		}
+		if extracted != seqNum {
+			t.Errorf("Sequence number mismatch: expected %d, got %d", seqNum, extracted)
+		}
+	}
+}
+
+func TestNewWithSequenceNumber_SortOrder(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	uuids := make([]string, 1000)
+	for i := range uuids {
+		uuid, err := uuidv8.NewWithSequenceNumber(uint64(i), node)
+		if err != nil {
+			t.Fatalf("NewWithSequenceNumber(%d) failed: %v", i, err)
+		}
+		uuids[i] = uuid
+	}
+
+	sorted := slices.Clone(uuids)
+	slices.Reverse(sorted)
+	slices.Sort(sorted)
+
+	for i, uuid := range sorted {
+		seqNum, err := uuidv8.ExtractSequenceNumber(uuid)
+		if err != nil {
+			t.Fatalf("ExtractSequenceNumber failed: %v", err)
+		}
+		if seqNum != uint64(i) {
+			t.Fatalf("Sorted UUID at index %d has sequence number %d", i, seqNum)
+		}
+	}
+}
+
+func TestNewWithSequenceNumber_InvalidInputs(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	if _, err := uuidv8.NewWithSequenceNumber(1<<48, node); err == nil {
+		t.Error("Expected error for sequence number exceeding 48 bits")
+	}
+	if _, err := uuidv8.NewWithSequenceNumber(1, []byte{0x01}); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+	if _, err := uuidv8.ExtractSequenceNumber("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+}
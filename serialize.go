@@ -0,0 +1,207 @@
+package uuidv8
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// SerializationFormat identifies a string representation of a UUIDv8 for Serialize and Deserialize.
+type SerializationFormat int
+
+// Built-in serialization formats.
+const (
+	SerializeUUIDString SerializationFormat = iota // Canonical form, e.g. 9a3d4049-0e2c-8080-0102-030405060000
+	SerializeCompact                               // 32 hex characters without dashes
+	SerializeBase64                                // 22 characters of unpadded URL-safe base64
+	SerializeBase58                                // Bitcoin-alphabet base58
+	SerializeURN                                   // RFC 4122 URN, e.g. urn:uuid:9a3d4049-0e2c-8080-0102-030405060000
+)
+
+// serializationFormat holds the marshal and unmarshal functions registered for a format.
+type serializationFormat struct {
+	name      string
+	marshal   func(*UUIDv8) (string, error)
+	unmarshal func(string) (*UUIDv8, error)
+}
+
+var (
+	formatsMu   sync.RWMutex
+	formats     = map[SerializationFormat]serializationFormat{}
+	formatNames = map[string]SerializationFormat{}
+	nextFormat  = SerializeURN + 1
+)
+
+func init() {
+	builtins := []struct {
+		format SerializationFormat
+		serializationFormat
+	}{
+		{SerializeUUIDString, serializationFormat{"uuid", marshalUUIDString, FromString}},
+		{SerializeCompact, serializationFormat{"compact", marshalCompact, unmarshalCompact}},
+		{SerializeBase64, serializationFormat{"base64", marshalBase64, unmarshalBase64}},
+		{SerializeBase58, serializationFormat{"base58", marshalBase58, unmarshalBase58}},
+		{SerializeURN, serializationFormat{"urn", marshalURN, unmarshalURN}},
+	}
+	for _, b := range builtins {
+		formats[b.format] = b.serializationFormat
+		formatNames[b.name] = b.format
+	}
+}
+
+// Serialize converts a UUIDv8 struct into the string representation identified by format.
+//
+// Parameters:
+// - format: A built-in SerializationFormat or one obtained from LookupFormat after RegisterFormat.
+//
+// Returns:
+// - The serialized UUIDv8.
+// - An error if the UUID is nil, the format is unknown, or the format's marshal function fails.
+func (u *UUIDv8) Serialize(format SerializationFormat) (string, error) {
+	if u == nil {
+		return "", errors.New("cannot serialize a nil UUIDv8")
+	}
+
+	f, err := lookupSerializationFormat(format)
+	if err != nil {
+		return "", err
+	}
+	return f.marshal(u)
+}
+
+// Deserialize parses a string produced by Serialize with the same format back into a UUIDv8 struct.
+//
+// Parameters:
+// - s: The serialized UUIDv8.
+// - format: The SerializationFormat s was serialized with.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if the format is unknown or s cannot be parsed.
+func Deserialize(s string, format SerializationFormat) (*UUIDv8, error) {
+	f, err := lookupSerializationFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	return f.unmarshal(s)
+}
+
+// RegisterFormat registers a user-defined serialization format under the given name.
+//
+// The SerializationFormat assigned to it can be retrieved with LookupFormat.
+//
+// Parameters:
+// - name: A unique, non-empty name for the format.
+// - marshal: The function converting a UUIDv8 struct into its string representation.
+// - unmarshal: The function parsing the string representation back into a UUIDv8 struct.
+//
+// Returns:
+// - An error if the name is empty or already registered, or if either function is nil.
+func RegisterFormat(name string, marshal func(*UUIDv8) (string, error), unmarshal func(string) (*UUIDv8, error)) error {
+	if name == "" {
+		return errors.New("format name must not be empty")
+	}
+	if marshal == nil || unmarshal == nil {
+		return fmt.Errorf("format %q must provide both marshal and unmarshal functions", name)
+	}
+
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	if _, exists := formatNames[name]; exists {
+		return fmt.Errorf("format %q is already registered", name)
+	}
+
+	formats[nextFormat] = serializationFormat{name, marshal, unmarshal}
+	formatNames[name] = nextFormat
+	nextFormat++
+	return nil
+}
+
+// Helper function to remove a user-defined format registered with RegisterFormat, used to keep tests independent.
+func unregisterFormat(name string) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	if format, exists := formatNames[name]; exists && format > SerializeURN {
+		delete(formats, format)
+		delete(formatNames, name)
+	}
+}
+
+// LookupFormat returns the SerializationFormat registered under the given name.
+//
+// The built-in formats are registered as "uuid", "compact", "base64", "base58" and "urn".
+//
+// Returns:
+// - The SerializationFormat and true if the name is registered, or false otherwise.
+func LookupFormat(name string) (SerializationFormat, bool) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+
+	format, ok := formatNames[name]
+	return format, ok
+}
+
+// Helper function to look up the marshal and unmarshal functions of a format.
+func lookupSerializationFormat(format SerializationFormat) (serializationFormat, error) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+
+	f, ok := formats[format]
+	if !ok {
+		return serializationFormat{}, fmt.Errorf("unknown serialization format: %d", format)
+	}
+	return f, nil
+}
+
+// Marshal and unmarshal functions of the built-in formats.
+
+func marshalUUIDString(u *UUIDv8) (string, error) {
+	return ToString(u), nil
+}
+
+func marshalCompact(u *UUIDv8) (string, error) {
+	return hex.EncodeToString(uuidv8Bytes(u)), nil
+}
+
+func unmarshalCompact(s string) (*UUIDv8, error) {
+	if len(s) != 32 {
+		return nil, fmt.Errorf("compact UUID must be 32 characters, got %d", len(s))
+	}
+	return FromString(s)
+}
+
+func marshalBase64(u *UUIDv8) (string, error) {
+	return base64.RawURLEncoding.EncodeToString(uuidv8Bytes(u)), nil
+}
+
+func unmarshalBase64(s string) (*UUIDv8, error) {
+	uuidBytes, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 UUID: %w", err)
+	}
+	return uuidv8FromBytes(uuidBytes)
+}
+
+func marshalBase58(u *UUIDv8) (string, error) {
+	return encodeBase58(uuidv8Bytes(u)), nil
+}
+
+func unmarshalBase58(s string) (*UUIDv8, error) {
+	uuidBytes, err := decodeBase58(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base58 UUID: %w", err)
+	}
+	return uuidv8FromBytes(uuidBytes)
+}
+
+func marshalURN(u *UUIDv8) (string, error) {
+	return ToURN(u), nil
+}
+
+func unmarshalURN(s string) (*UUIDv8, error) {
+	return FromURN(s)
+}
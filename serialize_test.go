@@ -0,0 +1,172 @@
+package uuidv8_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestSerialize_RoundTrip(t *testing.T) {
+	uuids := []string{
+		"9a3d4049-0e2c-8080-0102-030405060000",
+		"00000000-0001-8080-0000-000000000000", // Leading zero bytes
+		"ffffffff-ffff-8fbf-ffff-ffffffff0000", // Maximum values
+	}
+
+	formats := []struct {
+		format      uuidv8.SerializationFormat
+		length      int
+		description string
+	}{
+		{uuidv8.SerializeUUIDString, 36, "UUID string"},
+		{uuidv8.SerializeCompact, 32, "Compact"},
+		{uuidv8.SerializeBase64, 22, "Base64"},
+		{uuidv8.SerializeBase58, 0, "Base58"},
+		{uuidv8.SerializeURN, 45, "URN"},
+	}
+
+	for _, uuidStr := range uuids {
+		original, err := uuidv8.FromString(uuidStr)
+		if err != nil {
+			t.Fatalf("FromString failed: %v", err)
+		}
+
+		for _, test := range formats {
+			t.Run(test.description+" "+uuidStr, func(t *testing.T) {
+				serialized, err := original.Serialize(test.format)
+				if err != nil {
+					t.Fatalf("Serialize failed: %v", err)
+				}
+				if test.length != 0 && len(serialized) != test.length {
+					t.Errorf("Expected %d characters, got %d: %s", test.length, len(serialized), serialized)
+				}
+
+				deserialized, err := uuidv8.Deserialize(serialized, test.format)
+				if err != nil {
+					t.Fatalf("Deserialize failed for %s: %v", serialized, err)
+				}
+				if !reflect.DeepEqual(deserialized, original) {
+					t.Errorf("Round-trip mismatch: expected %+v, got %+v", original, deserialized)
+				}
+			})
+		}
+	}
+}
+
+func TestSerialize_KnownValues(t *testing.T) {
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+
+	tests := []struct {
+		format   uuidv8.SerializationFormat
+		expected string
+	}{
+		{uuidv8.SerializeUUIDString, "9a3d4049-0e2c-8080-0102-030405060000"},
+		{uuidv8.SerializeCompact, "9a3d40490e2c80800102030405060000"},
+		{uuidv8.SerializeBase64, "mj1ASQ4sgIABAgMEBQYAAA"},
+		{uuidv8.SerializeURN, "urn:uuid:9a3d4049-0e2c-8080-0102-030405060000"},
+	}
+
+	for _, test := range tests {
+		serialized, err := uuid.Serialize(test.format)
+		if err != nil {
+			t.Fatalf("Serialize failed: %v", err)
+		}
+		if serialized != test.expected {
+			t.Errorf("Expected %s, got %s", test.expected, serialized)
+		}
+	}
+}
+
+func TestSerialize_ErrorCases(t *testing.T) {
+	var nilUUID *uuidv8.UUIDv8
+	if _, err := nilUUID.Serialize(uuidv8.SerializeUUIDString); err == nil {
+		t.Error("Expected error when serializing a nil UUIDv8")
+	}
+
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	if _, err := uuid.Serialize(uuidv8.SerializationFormat(-1)); err == nil {
+		t.Error("Expected error for unknown serialization format")
+	}
+}
+
+func TestDeserialize_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		input       string
+		format      uuidv8.SerializationFormat
+		description string
+	}{
+		{"invalid-uuid", uuidv8.SerializeUUIDString, "Invalid UUID string"},
+		{"9a3d4049-0e2c-8080-0102-030405060000", uuidv8.SerializeCompact, "Dashed UUID as compact"},
+		{"mj1ASQ4sgIABAgMEBQYA", uuidv8.SerializeBase64, "Short base64"},
+		{"mj1ASQ4sgIABAgMEBQYAA!", uuidv8.SerializeBase64, "Invalid base64 character"},
+		{"0OIl", uuidv8.SerializeBase58, "Invalid base58 characters"},
+		{"2", uuidv8.SerializeBase58, "Short base58"},
+		{"9a3d4049-0e2c-8080-0102-030405060000", uuidv8.SerializeURN, "Missing URN prefix"},
+		{"urn:uuid:invalid", uuidv8.SerializeURN, "Invalid URN UUID"},
+		{"9a3d4049-0e2c-8080-0102-030405060000", uuidv8.SerializationFormat(-1), "Unknown format"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.Deserialize(test.input, test.format); err == nil {
+				t.Errorf("Expected error for input %s", test.input)
+			}
+		})
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	marshal := func(u *uuidv8.UUIDv8) (string, error) {
+		return strings.ToUpper(uuidv8.ToString(u)), nil
+	}
+	unmarshal := func(s string) (*uuidv8.UUIDv8, error) {
+		return uuidv8.FromString(strings.ToLower(s))
+	}
+
+	if err := uuidv8.RegisterFormat("test-upper", marshal, unmarshal); err != nil {
+		t.Fatalf("RegisterFormat failed: %v", err)
+	}
+	t.Cleanup(func() { uuidv8.UnregisterFormat("test-upper") })
+
+	format, ok := uuidv8.LookupFormat("test-upper")
+	if !ok {
+		t.Fatal("LookupFormat did not find the registered format")
+	}
+
+	uuid, _ := uuidv8.FromString("9a3d4049-0e2c-8080-0102-030405060000")
+	serialized, err := uuid.Serialize(format)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if serialized != "9A3D4049-0E2C-8080-0102-030405060000" {
+		t.Errorf("Unexpected serialization: %s", serialized)
+	}
+
+	deserialized, err := uuidv8.Deserialize(serialized, format)
+	if err != nil {
+		t.Fatalf("Deserialize failed: %v", err)
+	}
+	if !reflect.DeepEqual(deserialized, uuid) {
+		t.Errorf("Round-trip mismatch: expected %+v, got %+v", uuid, deserialized)
+	}
+
+	// Registration errors
+	if err := uuidv8.RegisterFormat("test-upper", marshal, unmarshal); err == nil {
+		t.Error("Expected error when registering a duplicate format name")
+	}
+	if err := uuidv8.RegisterFormat("base58", marshal, unmarshal); err == nil {
+		t.Error("Expected error when registering a built-in format name")
+	}
+	if err := uuidv8.RegisterFormat("", marshal, unmarshal); err == nil {
+		t.Error("Expected error when registering an empty format name")
+	}
+	if err := uuidv8.RegisterFormat("test-nil", nil, unmarshal); err == nil {
+		t.Error("Expected error when registering a nil marshal function")
+	}
+
+	if _, ok := uuidv8.LookupFormat("does-not-exist"); ok {
+		t.Error("LookupFormat found an unregistered format")
+	}
+}
@@ -0,0 +1,55 @@
+package uuidv8
+
+import "strings"
+
+// ToSQLType returns the column type to use for UUIDv8 values in the given SQL dialect.
+//
+// Supported dialects are "postgres", "cockroachdb", "mysql", "sqlite" and "sqlserver"
+// (case-insensitive). The receiver is not used, so the method may be called on a nil *UUIDv8.
+//
+// Parameters:
+// - dialect: The SQL dialect name.
+//
+// Returns:
+// - The SQL column type, or an empty string for unknown dialects.
+func (u *UUIDv8) ToSQLType(dialect string) string {
+	switch strings.ToLower(dialect) {
+	case "postgres", "cockroachdb":
+		return "uuid"
+	case "mysql", "sqlite":
+		return "char(36)"
+	case "sqlserver":
+		return "uniqueidentifier"
+	default:
+		return ""
+	}
+}
+
+// ToSQLDefault returns the SQL expression that generates a default UUID in the given SQL dialect.
+//
+// None of the database functions generate UUIDv8s:
+// - Postgres and CockroachDB: gen_random_uuid() returns random (version 4) UUIDs.
+// - MySQL: UUID() returns time-based (version 1) UUIDs.
+// - SQL Server: NEWID() returns random (version 4) UUIDs.
+//
+// Use them only where a database-side default is acceptable and generate UUIDv8s in the
+// application otherwise. SQLite has no built-in UUID function, so an empty string is returned for
+// it. The receiver is not used, so the method may be called on a nil *UUIDv8.
+//
+// Parameters:
+// - dialect: The SQL dialect name.
+//
+// Returns:
+// - The SQL default expression, or an empty string if the dialect is unknown or has none.
+func (u *UUIDv8) ToSQLDefault(dialect string) string {
+	switch strings.ToLower(dialect) {
+	case "postgres", "cockroachdb":
+		return "gen_random_uuid()"
+	case "mysql":
+		return "(UUID())"
+	case "sqlserver":
+		return "NEWID()"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,36 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestUUIDv8_ToSQLType(t *testing.T) {
+	tests := []struct {
+		dialect         string
+		expectedType    string
+		expectedDefault string
+	}{
+		{"postgres", "uuid", "gen_random_uuid()"},
+		{"cockroachdb", "uuid", "gen_random_uuid()"},
+		{"mysql", "char(36)", "(UUID())"},
+		{"sqlite", "char(36)", ""},
+		{"sqlserver", "uniqueidentifier", "NEWID()"},
+		{"Postgres", "uuid", "gen_random_uuid()"},
+		{"oracle", "", ""},
+		{"", "", ""},
+	}
+
+	var uuid *uuidv8.UUIDv8
+	for _, test := range tests {
+		t.Run("Dialect "+test.dialect, func(t *testing.T) {
+			if result := uuid.ToSQLType(test.dialect); result != test.expectedType {
+				t.Errorf("ToSQLType: expected %q, got %q", test.expectedType, result)
+			}
+			if result := uuid.ToSQLDefault(test.dialect); result != test.expectedDefault {
+				t.Errorf("ToSQLDefault: expected %q, got %q", test.expectedDefault, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package uuidv8
+
+import "fmt"
+
+// StripCustomData removes the machine-identifying parts of a UUIDv8 while preserving its timestamp.
+//
+// The node bytes (and the unused trailing bytes) are zeroed, as is the clock sequence. The version
+// and variant bits are kept, so the result is still a valid UUIDv8 that sorts at the same point in
+// time as the original but carries no machine identity. Useful for privacy-redaction pipelines.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The redacted UUIDv8 string.
+// - An error if the input is not a valid UUIDv8.
+func StripCustomData(uuid string) (string, error) {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to strip custom data: %w", err)
+	}
+
+	// Keep the version nibble and the variant bits, drop the clock sequence
+	uuidBytes[6] &= 0xF0
+	uuidBytes[7] &= 0xC0
+
+	// Drop the node
+	clear(uuidBytes[8:])
+
+	return formatUUID(uuidBytes, false), nil
+}
+
+// StripTimestamp zeroes the timestamp of a UUIDv8 while preserving its clock sequence and node.
+//
+// This is the counterpart of StripCustomData: the result hides when the UUID was generated but
+// still identifies where it came from.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The redacted UUIDv8 string.
+// - An error if the input is not a valid UUIDv8.
+func StripTimestamp(uuid string) (string, error) {
+	uuidBytes, err := parseUUIDv8(uuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to strip timestamp: %w", err)
+	}
+
+	clear(uuidBytes[:6])
+
+	return formatUUID(uuidBytes, false), nil
+}
@@ -0,0 +1,88 @@
+package uuidv8_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestStripCustomData(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	timestamp := uint64(1633024800000000000)
+
+	uuid, err := uuidv8.NewWithParams(timestamp, 0x0ABC, node, uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("NewWithParams failed: %v", err)
+	}
+
+	stripped, err := uuidv8.StripCustomData(uuid)
+	if err != nil {
+		t.Fatalf("StripCustomData failed: %v", err)
+	}
+
+	if !uuidv8.IsValidUUIDv8(stripped) {
+		t.Errorf("StripCustomData produced an invalid UUIDv8: %s", stripped)
+	}
+
+	original, _ := uuidv8.FromString(uuid)
+	parsed, _ := uuidv8.FromString(stripped)
+	if parsed.Timestamp != original.Timestamp {
+		t.Errorf("Timestamp mismatch: expected %d, got %d", original.Timestamp, parsed.Timestamp)
+	}
+	if !bytes.Equal(parsed.Node, make([]byte, 6)) {
+		t.Errorf("Expected zeroed node, got %x", parsed.Node)
+	}
+	if parsed.ClockSeq&0x0F3F != 0 {
+		t.Errorf("Expected zeroed clock sequence, got %#x", parsed.ClockSeq)
+	}
+}
+
+func TestStripTimestamp(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	uuid, err := uuidv8.NewWithParams(1633024800000000000, 0x0ABC, node, uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("NewWithParams failed: %v", err)
+	}
+
+	stripped, err := uuidv8.StripTimestamp(uuid)
+	if err != nil {
+		t.Fatalf("StripTimestamp failed: %v", err)
+	}
+
+	if !uuidv8.IsValidUUIDv8(stripped) {
+		t.Errorf("StripTimestamp produced an invalid UUIDv8: %s", stripped)
+	}
+
+	original, _ := uuidv8.FromString(uuid)
+	parsed, _ := uuidv8.FromString(stripped)
+	if parsed.Timestamp != 0 {
+		t.Errorf("Expected zero timestamp, got %d", parsed.Timestamp)
+	}
+	if parsed.ClockSeq != original.ClockSeq {
+		t.Errorf("ClockSeq mismatch: expected %#x, got %#x", original.ClockSeq, parsed.ClockSeq)
+	}
+	if !bytes.Equal(parsed.Node, node) {
+		t.Errorf("Node mismatch: expected %x, got %x", node, parsed.Node)
+	}
+}
+
+func TestStrip_InvalidInputs(t *testing.T) {
+	invalidUUIDs := []string{
+		"invalid-uuid",
+		"00000000-0000-0000-0000-000000000000", // All-zero UUID
+		"0193bde4-a9fa-77eb-a304-6cf8530ece78", // A UUIDv7
+	}
+
+	for _, uuid := range invalidUUIDs {
+		t.Run("Invalid UUID "+uuid, func(t *testing.T) {
+			if _, err := uuidv8.StripCustomData(uuid); err == nil {
+				t.Errorf("StripCustomData: expected error for %s", uuid)
+			}
+			if _, err := uuidv8.StripTimestamp(uuid); err == nil {
+				t.Errorf("StripTimestamp: expected error for %s", uuid)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package uuidv8
+
+import "fmt"
+
+// ToURN converts a UUIDv8 struct into its RFC 4122 URN representation.
+//
+// Parameters:
+// - uuidv8: A pointer to a UUIDv8 struct containing the components (timestamp, clockSeq, node).
+//
+// Returns:
+// - The canonical UUID string prefixed with "urn:uuid:".
+func ToURN(uuidv8 *UUIDv8) string {
+	return urnPrefix + ToString(uuidv8)
+}
+
+// FromURN parses a UUID in RFC 4122 URN form ("urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx") into its components.
+//
+// The prefix is matched case-insensitively, as required by RFC 8141. The UUID portion must be in
+// the canonical 36-character form and is parsed with FromString.
+//
+// Parameters:
+// - urn: The URN string.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+// - An error if the "urn:uuid:" prefix is missing or the UUID portion cannot be parsed.
+func FromURN(urn string) (*UUIDv8, error) {
+	uuid, err := trimURNPrefix(urn)
+	if err != nil {
+		return nil, err
+	}
+	return FromString(uuid)
+}
+
+// FromURNOrNil parses a UUID in RFC 4122 URN form, returning nil if invalid or all zero.
+//
+// Parameters:
+// - urn: The URN string.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct if the URN is valid.
+// - Nil if the URN is invalid or represents an all-zero UUID.
+func FromURNOrNil(urn string) *UUIDv8 {
+	uuid, err := trimURNPrefix(urn)
+	if err != nil {
+		return nil
+	}
+	return FromStringOrNil(uuid)
+}
+
+// IsValidURN validates if a given string is a UUIDv8 in RFC 4122 URN form.
+//
+// Parameters:
+// - s: The string to validate.
+//
+// Returns:
+// - A boolean indicating whether s carries the "urn:uuid:" prefix followed by a valid UUIDv8.
+func IsValidURN(s string) bool {
+	return hasURNPrefix(s) && IsValidUUIDv8(s)
+}
+
+// Helper function to strip the URN prefix, requiring the canonical 36-character UUID after it.
+func trimURNPrefix(urn string) (string, error) {
+	if !hasURNPrefix(urn) {
+		return "", fmt.Errorf("URN must start with %q", urnPrefix)
+	}
+
+	uuid := urn[len(urnPrefix):]
+	if len(uuid) != 36 {
+		return "", fmt.Errorf("failed to parse URN: %w: expected the canonical 36-character form", ErrInvalidFormat)
+	}
+	return uuid, nil
+}
@@ -0,0 +1,79 @@
+package uuidv8_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestToURN(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+	uuid, err := uuidv8.FromString(uuidStr)
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	urn := uuidv8.ToURN(uuid)
+	if urn != "urn:uuid:"+uuidStr {
+		t.Errorf("Expected urn:uuid:%s, got %s", uuidStr, urn)
+	}
+	if !uuidv8.IsValidURN(urn) {
+		t.Errorf("IsValidURN should accept %s", urn)
+	}
+
+	for _, input := range []string{urn, strings.ToUpper(urn), "URN:uuid:" + uuidStr} {
+		parsed, err := uuidv8.FromURN(input)
+		if err != nil {
+			t.Fatalf("FromURN(%s) failed: %v", input, err)
+		}
+		if got := uuidv8.ToString(parsed); got != uuidStr {
+			t.Errorf("Round-trip mismatch: expected %s, got %s", uuidStr, got)
+		}
+		if parsed := uuidv8.FromURNOrNil(input); parsed == nil || uuidv8.ToString(parsed) != uuidStr {
+			t.Errorf("FromURNOrNil failed to parse %s", input)
+		}
+	}
+}
+
+func TestFromURN_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		input       string
+		description string
+	}{
+		{"9a3d4049-0e2c-8080-0102-030405060000", "Missing prefix"},
+		{"urn:uid:9a3d4049-0e2c-8080-0102-030405060000", "Wrong prefix"},
+		{"urn:uuid:", "Empty UUID"},
+		{"urn:uuid:not-a-uuid", "Invalid UUID"},
+		{"urn:uuid:urn:uuid:9a3d4049-0e2c-8080-0102-030405060000", "Repeated prefix"},
+		{"urn:uuid:9a3d40490e2c80800102030405060000", "Compact UUID"},
+		{"urn:uuid:{9a3d4049-0e2c-8080-0102-030405060000}", "Braced UUID"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if _, err := uuidv8.FromURN(test.input); err == nil {
+				t.Errorf("Expected error for %q", test.input)
+			}
+			if uuidv8.FromURNOrNil(test.input) != nil {
+				t.Errorf("Expected nil for %q", test.input)
+			}
+			if uuidv8.IsValidURN(test.input) {
+				t.Errorf("IsValidURN should reject %q", test.input)
+			}
+		})
+	}
+
+	// A missing prefix is reported as such rather than as an invalid length
+	if _, err := uuidv8.FromURN("9a3d4049-0e2c-8080-0102-030405060000"); err == nil || !strings.Contains(err.Error(), "urn:uuid:") {
+		t.Errorf("Expected error mentioning the missing prefix, got %v", err)
+	}
+
+	const zero = "urn:uuid:00000000-0000-0000-0000-000000000000"
+	if uuidv8.FromURNOrNil(zero) != nil {
+		t.Error("Expected nil for all-zero URN")
+	}
+	if uuidv8.IsValidURN(zero) {
+		t.Error("IsValidURN should reject the all-zero URN")
+	}
+}
@@ -0,0 +1,89 @@
+package uuidv8
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// UUID58 is a UUIDv8 in its base58 representation.
+//
+// Applications that expose base58 as their canonical ID format can use UUID58 instead of string
+// so that the type system keeps base58 and canonical UUID strings apart. The encoding matches
+// SerializeBase58.
+type UUID58 string
+
+// NewUUID58 generates a UUIDv8 with default parameters (see New) and encodes it as base58.
+//
+// Returns:
+// - The base58 representation of the generated UUIDv8.
+// - An error if any component generation fails.
+func NewUUID58() (UUID58, error) {
+	uuid, err := New()
+	if err != nil {
+		return "", err
+	}
+
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return "", err
+	}
+	return UUID58(encodeBase58(uuidBytes)), nil
+}
+
+// ToUUIDv8 decodes the base58 representation into its UUIDv8 components.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the decoded components.
+// - An error if u is not valid base58, does not decode to 16 bytes, or is not a valid UUIDv8.
+func (u UUID58) ToUUIDv8() (*UUIDv8, error) {
+	uuidBytes, err := decodeBase58(string(u))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base58 UUID: %w", err)
+	}
+	if len(uuidBytes) != 16 {
+		return nil, fmt.Errorf("UUID must be 16 bytes, got %d bytes", len(uuidBytes))
+	}
+	if err := validateUUIDv8Bytes(uuidBytes, variantRFC4122); err != nil {
+		return nil, err
+	}
+	return decodeUUIDv8(uuidBytes), nil
+}
+
+// IsValid reports whether u is the base58 representation of a valid UUIDv8.
+func (u UUID58) IsValid() bool {
+	_, err := u.ToUUIDv8()
+	return err == nil
+}
+
+// String returns the base58 representation.
+func (u UUID58) String() string {
+	return string(u)
+}
+
+// Value implements the [driver.Valuer] interface, storing the base58 string.
+func (u UUID58) Value() (driver.Value, error) {
+	if !u.IsValid() {
+		return nil, fmt.Errorf("invalid UUID58: %q", string(u))
+	}
+	return string(u), nil
+}
+
+// Scan implements the [sql.Scanner] interface, reading a base58 string.
+func (u *UUID58) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return errors.New("unsupported type for UUID58")
+	}
+
+	if !UUID58(s).IsValid() {
+		return fmt.Errorf("invalid UUID58: %q", s)
+	}
+	*u = UUID58(s)
+	return nil
+}
@@ -0,0 +1,121 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewUUID58(t *testing.T) {
+	id, err := uuidv8.NewUUID58()
+	if err != nil {
+		t.Fatalf("NewUUID58 failed: %v", err)
+	}
+	if !id.IsValid() {
+		t.Errorf("NewUUID58 generated an invalid UUID58: %s", id)
+	}
+	if id.String() != string(id) {
+		t.Errorf("String mismatch: expected %s, got %s", string(id), id.String())
+	}
+
+	uuid, err := id.ToUUIDv8()
+	if err != nil {
+		t.Fatalf("ToUUIDv8 failed: %v", err)
+	}
+
+	// The type must use the same encoding as SerializeBase58
+	encoded, err := uuid.Serialize(uuidv8.SerializeBase58)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if encoded != string(id) {
+		t.Errorf("Expected %s, got %s", encoded, id)
+	}
+}
+
+func TestUUID58_Invalid(t *testing.T) {
+	tests := []struct {
+		input       uuidv8.UUID58
+		description string
+	}{
+		{"", "Empty string"},
+		{"0OIl", "Characters outside the alphabet"},
+		{"2NEpo7TZRRrLZSi2U", "Too short"},
+		{"9a3d4049-0e2c-8080-0102-030405060000", "Canonical UUID string"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if test.input.IsValid() {
+				t.Errorf("Expected %q to be invalid", test.input)
+			}
+			if _, err := test.input.ToUUIDv8(); err == nil {
+				t.Errorf("Expected error for %q", test.input)
+			}
+		})
+	}
+}
+
+func TestUUID58_Database(t *testing.T) {
+	id, err := uuidv8.NewUUID58()
+	if err != nil {
+		t.Fatalf("NewUUID58 failed: %v", err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO items").WithArgs(string(id)).WillReturnResult(sqlmock.NewResult(1, 1))
+	if _, err := db.Exec("INSERT INTO items (id) VALUES (?)", id); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	mock.ExpectQuery("SELECT id FROM items").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(string(id)))
+	var scanned uuidv8.UUID58
+	if err := db.QueryRow("SELECT id FROM items").Scan(&scanned); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if scanned != id {
+		t.Errorf("Expected %s, got %s", id, scanned)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestUUID58_ValueScan_EdgeCases(t *testing.T) {
+	if _, err := uuidv8.UUID58("invalid").Value(); err == nil {
+		t.Error("Value: expected error for invalid UUID58")
+	}
+
+	tests := []struct {
+		name        string
+		input       interface{}
+		expectError bool
+	}{
+		{"Invalid String", "invalid", true},
+		{"Invalid Type", 12345, true},
+		{"Empty Bytes", []byte{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var id uuidv8.UUID58
+			err := id.Scan(test.input)
+			if (err != nil) != test.expectError {
+				t.Errorf("Unexpected error status for input %v: got %v, want error=%v", test.input, err, test.expectError)
+			}
+		})
+	}
+
+	id, _ := uuidv8.NewUUID58()
+	var scanned uuidv8.UUID58
+	if err := scanned.Scan([]byte(id)); err != nil || scanned != id {
+		t.Errorf("Scan of []byte failed: got %s, err %v", scanned, err)
+	}
+}
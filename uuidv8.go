@@ -3,12 +3,14 @@
 package uuidv8
 
 import (
-	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -18,6 +20,9 @@ const (
 	versionV8      = 0x8  // Version bits for UUIDv8
 )
 
+// urnPrefix is the RFC 4122 URN namespace prefix for UUIDs.
+const urnPrefix = "urn:uuid:"
+
 // Supported timestamp bit sizes for UUIDv8.
 const (
 	TimestampBits32 = 32 // Use 32-bit timestamp
@@ -48,24 +53,7 @@ type UUIDv8 struct {
 // - A string representation of the generated UUIDv8.
 // - An error if any component generation fails.
 func New() (string, error) {
-	// Current timestamp
-	timestamp := uint64(time.Now().UnixNano())
-
-	// Random clock sequence
-	clockSeq := make([]byte, 2)
-	if _, err := rand.Read(clockSeq); err != nil {
-		return "", fmt.Errorf("failed to generate random clock sequence: %w", err)
-	}
-	clockSeqValue := binary.BigEndian.Uint16(clockSeq) & 0x0FFF // Mask to 12 bits
-
-	// Random node
-	node := make([]byte, 6)
-	if _, err := rand.Read(node); err != nil {
-		return "", fmt.Errorf("failed to generate random node: %w", err)
-	}
-
-	// Generate UUIDv8
-	return NewWithParams(timestamp, clockSeqValue, node, TimestampBits48)
+	return newWithTimestamp(uint64(time.Now().UnixNano()))
 }
 
 // NewWithParams generates a new UUIDv8 based on the provided timestamp, clock sequence, and node.
@@ -80,32 +68,120 @@ func New() (string, error) {
 // - A string representation of the generated UUIDv8.
 // - An error if the input parameters are invalid (e.g., incorrect node length or unsupported timestamp size).
 func NewWithParams(timestamp uint64, clockSeq uint16, node []byte, timestampBits int) (string, error) {
-	if len(node) != 6 {
-		return "", fmt.Errorf("node must be 6 bytes, got %d bytes", len(node))
+	uuid, err := buildUUID(timestamp, clockSeq, node, timestampBits, variantRFC4122)
+	if err != nil {
+		return "", err
 	}
 
-	uuid := make([]byte, 16)
+	return formatUUID(uuid, false), nil
+}
 
-	// Set timestamp
-	if err := encodeTimestamp(uuid, timestamp, timestampBits); err != nil {
+// NewFromNamespace generates a deterministic UUIDv8 from a namespace and a name.
+//
+// Like UUIDv5, the same inputs always produce the same UUID, which gives entities with a natural
+// string key (e.g. an email address) a stable ID without a lookup. SHA-256(namespace || name)
+// fills the timestamp from its first timestampBits bits, the clock sequence from the next 12 bits
+// and the node from the next 48 bits; the version and variant bits are then stamped over them.
+//
+// Parameters:
+// - namespace: A 16-byte namespace, e.g. the raw bytes of a UUID identifying the application.
+// - name: The name to derive the UUID from.
+// - timestampBits: The number of hash bits used for the timestamp (32, 48, or 60).
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the timestamp bit size is unsupported.
+func NewFromNamespace(namespace [16]byte, name []byte, timestampBits int) (string, error) {
+	switch timestampBits {
+	case TimestampBits32, TimestampBits48, TimestampBits60:
+	default:
+		return "", fmt.Errorf("unsupported timestamp bit size: %d", timestampBits)
+	}
+
+	h := sha256.New()
+	h.Write(namespace[:])
+	h.Write(name)
+	sum := h.Sum(nil)
+
+	timestamp := readBits(sum, 0, timestampBits)
+	clockSeq := uint16(readBits(sum, timestampBits, 12))
+
+	node := make([]byte, 8)
+	binary.BigEndian.PutUint64(node, readBits(sum, timestampBits+12, 48))
+
+	uuid, err := buildUUID(timestamp, clockSeq, node[2:], timestampBits, variantRFC4122)
+	if err != nil {
 		return "", err
 	}
+	return formatUUID(uuid, false), nil
+}
 
-	// Set version and clock sequence
-	uuid[6] = (byte(versionV8) << 4) | byte(clockSeq>>8)
-	uuid[7] = byte(clockSeq)
+// CustomFields describes the components of a UUIDv8 for NewWithCustomFields.
+//
+// Zero values select defaults, which makes the struct self-documenting at call sites:
+// - Timestamp: 0 uses the current time in nanoseconds.
+// - ClockSeq: 0 uses a random 12-bit value.
+// - Node: nil uses NodeFunc if set, or a random 6-byte node otherwise.
+// - TimestampBits: 0 uses TimestampBits48.
+type CustomFields struct {
+	Timestamp     uint64                 // The timestamp component of the UUID.
+	ClockSeq      uint16                 // The clock sequence component of the UUID.
+	Node          []byte                 // The node component of the UUID (6 bytes).
+	TimestampBits int                    // The number of bits in the timestamp (32, 48, or 60).
+	NodeFunc      func() ([]byte, error) // Optional source of the node when Node is nil.
+}
 
-	// Set variant
-	uuid[7] = (uuid[7] & 0x3F) | (variantRFC4122 << 6)
+// NewWithCustomFields generates a new UUIDv8 from the components described by f.
+//
+// Parameters:
+// - f: The UUIDv8 components. Zero-valued fields fall back to the defaults documented on CustomFields.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if a default component cannot be generated or the resulting parameters are invalid.
+func NewWithCustomFields(f CustomFields) (string, error) {
+	if f.Timestamp == 0 {
+		f.Timestamp = uint64(time.Now().UnixNano())
+	}
+
+	if f.ClockSeq == 0 {
+		clockSeq, err := randomClockSeq()
+		if err != nil {
+			return "", err
+		}
+		f.ClockSeq = clockSeq
+	}
+
+	if f.Node == nil {
+		var err error
+		if f.NodeFunc != nil {
+			f.Node, err = f.NodeFunc()
+			if err != nil {
+				return "", fmt.Errorf("failed to generate node: %w", err)
+			}
+		} else {
+			f.Node, err = randomNode()
+			if err != nil {
+				return "", err
+			}
+		}
+	}
 
-	// Copy node
-	copy(uuid[8:], node)
+	if f.TimestampBits == 0 {
+		f.TimestampBits = TimestampBits48
+	}
 
-	return formatUUID(uuid), nil
+	return NewWithParams(f.Timestamp, f.ClockSeq, f.Node, f.TimestampBits)
 }
 
 // FromString parses a UUIDv8 string into its components.
 //
+// Hex digits are accepted in lowercase, uppercase or mixed case.
+//
+// The timestamp is always decoded as 48 bits. UUIDs generated with TimestampBits32 or
+// TimestampBits60 decode to a different timestamp (shifted left by 16 bits, or truncated to the
+// top 48 bits respectively); use FromStringWithBits for those.
+//
 // Parameters:
 // - uuid: A string representation of a UUIDv8.
 //
@@ -118,25 +194,36 @@ func FromString(uuid string) (*UUIDv8, error) {
 		return nil, fmt.Errorf("failed to parse UUID: %w", err)
 	}
 
-	// Decode timestamp (48 bits default, adjust for compatibility)
-	timestamp := uint64(uuidBytes[0])<<40 |
-		uint64(uuidBytes[1])<<32 |
-		uint64(uuidBytes[2])<<24 |
-		uint64(uuidBytes[3])<<16 |
-		uint64(uuidBytes[4])<<8 |
-		uint64(uuidBytes[5])
-
-	// Decode clock sequence (12 bits)
-	clockSeq := uint16(uuidBytes[6]&0x0F)<<8 | uint16(uuidBytes[7])
-
-	// Decode node (last 6 bytes)
-	node := uuidBytes[8:14]
-
-	return &UUIDv8{
-		Timestamp: timestamp,
-		ClockSeq:  clockSeq,
-		Node:      node,
-	}, nil
+	return decodeUUIDv8(uuidBytes), nil
+}
+
+// FromStringWithBits parses a UUIDv8 string whose timestamp was encoded with the given bit size.
+//
+// It inverts the timestamp encoding of NewWithParams for each supported bit size. The 60-bit
+// encoding cannot be inverted exactly: its low 12 bits share byte 6 with the version and clock
+// sequence and are lost, so the decoded timestamp is rounded down to a multiple of 4096.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+// - timestampBits: The number of bits in the timestamp (32, 48, or 60).
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components (timestamp, clockSeq, node).
+// - An error if the UUID cannot be parsed or the timestamp bit size is unsupported.
+func FromStringWithBits(uuid string, timestampBits int) (*UUIDv8, error) {
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse UUID: %w", err)
+	}
+
+	timestamp, err := decodeTimestampWithBits(uuidBytes, timestampBits)
+	if err != nil {
+		return nil, err
+	}
+
+	u := decodeUUIDv8(uuidBytes)
+	u.Timestamp = timestamp
+	return u, nil
 }
 
 // FromStringOrNil parses a UUIDv8 string into its components, returning nil if invalid or all zero.
@@ -153,19 +240,13 @@ func FromStringOrNil(uuid string) *UUIDv8 {
 		return nil
 	}
 
-	timestamp := decodeTimestamp(uuidBytes[:6])
-	clockSeq := uint16(uuidBytes[6]&0x0F)<<8 | uint16(uuidBytes[7])
-	node := uuidBytes[8:14]
-
-	return &UUIDv8{
-		Timestamp: timestamp,
-		ClockSeq:  clockSeq,
-		Node:      node,
-	}
+	return decodeUUIDv8(uuidBytes)
 }
 
 // IsValidUUIDv8 validates if a given string is a valid UUIDv8.
 //
+// Hex digits are accepted in lowercase, uppercase or mixed case.
+//
 // Parameters:
 // - uuid: A string representation of a UUID.
 //
@@ -174,18 +255,10 @@ func FromStringOrNil(uuid string) *UUIDv8 {
 //   - `true` if the UUID has the correct version and variant bits and is well-formed.
 //   - `false` if the UUID is invalid or all zero.
 func IsValidUUIDv8(uuid string) bool {
-	uuidBytes, err := parseUUID(uuid)
-	if err != nil || isAllZeroUUID(uuidBytes) {
-		return false
-	}
-
-	version := uuidBytes[6] >> 4
-	variant := (uuidBytes[7] >> 6) & 0x03
-
-	return version == versionV8 && variant == variantRFC4122
+	return Validate(uuid) == nil
 }
 
-// ToString converts a UUIDv8 struct into its string representation.
+// ToString converts a UUIDv8 struct into its canonical lowercase string representation.
 //
 // Parameters:
 // - uuidv8: A pointer to a UUIDv8 struct containing the components (timestamp, clockSeq, node).
@@ -193,25 +266,165 @@ func IsValidUUIDv8(uuid string) bool {
 // Returns:
 // - A string representation of the UUIDv8.
 func ToString(uuidv8 *UUIDv8) string {
-	uuid := make([]byte, 16)
+	var uuid [16]byte
+	encodeUUIDv8(uuid[:], uuidv8)
+	return formatUUID(uuid[:], false)
+}
+
+// ToStringUpper converts a UUIDv8 struct into its string representation with uppercase hex digits.
+//
+// Some systems (e.g. SQL Server, Windows registry values) require uppercase UUIDs. Parsing
+// functions accept both cases.
+//
+// Parameters:
+// - uuidv8: A pointer to a UUIDv8 struct containing the components (timestamp, clockSeq, node).
+//
+// Returns:
+// - An uppercase string representation of the UUIDv8.
+func ToStringUpper(uuidv8 *UUIDv8) string {
+	var uuid [16]byte
+	encodeUUIDv8(uuid[:], uuidv8)
+	return formatUUID(uuid[:], true)
+}
+
+// MarshalText serializes a UUIDv8 object into its canonical string representation.
+//
+// It implements [encoding.TextMarshaler], so UUIDv8 works with encoding/xml, YAML and CSV encoders
+// and anything else that checks for it.
+//
+// Returns:
+// - The canonical hyphenated lowercase string, as produced by ToString.
+// - An error if the object is not a valid UUIDv8.
+func (u *UUIDv8) MarshalText() ([]byte, error) {
+	// Validate the UUIDv8 object before conversion
+	if u == nil || len(u.Node) != 6 || u.Timestamp == 0 || u.ClockSeq > 0x0FFF {
+		return nil, fmt.Errorf("object is not a valid UUIDv8")
+	}
+
+	// Convert to string and validate
+	uuidStr := ToString(u)
+	if !IsValidUUIDv8(uuidStr) {
+		return nil, fmt.Errorf("string representation is not a valid UUIDv8")
+	}
+
+	return []byte(uuidStr), nil
+}
+
+// UnmarshalText deserializes a UUIDv8 string into a UUIDv8 object.
+//
+// It implements [encoding.TextUnmarshaler].
+//
+// Parameters:
+// - text: The UUID string, in any format accepted by FromString.
+//
+// Returns:
+// - An error if the text is not a valid UUIDv8 or cannot be parsed.
+func (u *UUIDv8) UnmarshalText(text []byte) error {
+	uuidStr := string(text)
 
-	// Encode timestamp
-	err := encodeTimestamp(uuid, uuidv8.Timestamp, TimestampBits48)
+	// Ensure the UUID string is valid and represents a UUIDv8
+	if !IsValidUUIDv8(uuidStr) {
+		return fmt.Errorf("input is not a valid UUIDv8: %s", uuidStr)
+	}
+
+	parsed, err := FromString(uuidStr)
 	if err != nil {
+		return fmt.Errorf("failed to parse UUID string: %w", err)
+	}
+
+	*u = *parsed
+	return nil
+}
+
+// AppendFormatted appends the canonical string representation of the UUIDv8 to dst.
+//
+// It produces the same 36 characters as ToString without allocating, provided dst has room for
+// them. A nil UUIDv8 appends nothing.
+//
+// Parameters:
+// - dst: The buffer to append to. May be nil.
+//
+// Returns:
+// - The extended buffer.
+func (u *UUIDv8) AppendFormatted(dst []byte) []byte {
+	if u == nil {
+		return dst
+	}
+
+	var uuid [16]byte
+	encodeUUIDv8(uuid[:], u)
+	return appendFormattedUUID(dst, uuid[:], false)
+}
+
+// String returns the canonical string representation of the UUIDv8, as produced by ToString.
+//
+// It implements [fmt.Stringer], so UUIDs print as strings with fmt verbs such as %s, %v and %q
+// and in loggers that check for it. A nil UUIDv8 yields an empty string.
+func (u *UUIDv8) String() string {
+	if u == nil {
 		return ""
 	}
+	return ToString(u)
+}
+
+// Set parses a UUIDv8 string into the receiver.
+//
+// Together with String it implements [flag.Value], so a UUIDv8 can be bound to a command-line
+// flag with flag.Var (or pflag.Var).
+//
+// Parameters:
+// - s: A string representation of a UUIDv8.
+//
+// Returns:
+// - An error if s is not a valid UUIDv8.
+func (u *UUIDv8) Set(s string) error {
+	if err := u.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("invalid UUIDv8 value %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalBinary serializes a UUIDv8 object into its 16-byte binary representation.
+//
+// It implements [encoding.BinaryMarshaler] for encoding/gob, msgpack and similar compact formats.
+// The layout matches ToByteArray.
+//
+// Returns:
+// - The 16 raw UUID bytes.
+// - An error if the UUID is nil or its node is not 6 bytes.
+func (u *UUIDv8) MarshalBinary() ([]byte, error) {
+	if u == nil {
+		return nil, errors.New("cannot marshal a nil UUIDv8")
+	}
+	if len(u.Node) != 6 {
+		return nil, fmt.Errorf("invalid UUIDv8: node length must be 6 bytes, got %d bytes", len(u.Node))
+	}
 
-	// Set clock sequence and version
-	uuid[6] = (byte(versionV8) << 4) | byte(uuidv8.ClockSeq>>8)
-	uuid[7] = byte(uuidv8.ClockSeq)
+	b := ToByteArray(u)
+	return b[:], nil
+}
 
-	// Set variant
-	uuid[7] = (uuid[7] & 0x3F) | (variantRFC4122 << 6)
+// UnmarshalBinary deserializes the 16-byte binary representation of a UUIDv8 into a UUIDv8 object.
+//
+// It implements [encoding.BinaryUnmarshaler].
+//
+// Parameters:
+// - data: The 16 raw UUID bytes.
+//
+// Returns:
+// - An error if data is not 16 bytes or does not form a valid UUIDv8.
+func (u *UUIDv8) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return fmt.Errorf("UUID must be 16 bytes, got %d bytes", len(data))
+	}
 
-	// Copy node
-	copy(uuid[8:], uuidv8.Node)
+	parsed, err := FromByteArray([16]byte(data))
+	if err != nil {
+		return err
+	}
 
-	return formatUUID(uuid)
+	*u = *parsed
+	return nil
 }
 
 // MarshalJSON serializes a UUIDv8 object into its JSON representation.
@@ -220,18 +433,11 @@ func ToString(uuidv8 *UUIDv8) string {
 // - A JSON-encoded byte slice of the UUID string.
 // - An error if the serialization fails.
 func (u *UUIDv8) MarshalJSON() ([]byte, error) {
-	// Validate the UUIDv8 object before conversion
-	if u == nil || len(u.Node) != 6 || u.Timestamp == 0 || u.ClockSeq > 0x0FFF {
-		return nil, fmt.Errorf("object is not a valid UUIDv8")
-	}
-
-	// Convert to string and validate
-	uuidStr := ToString(u)
-	if !IsValidUUIDv8(uuidStr) {
-		return nil, fmt.Errorf("string representation is not a valid UUIDv8")
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
 	}
-
-	return json.Marshal(uuidStr)
+	return json.Marshal(string(text))
 }
 
 // UnmarshalJSON deserializes a JSON-encoded UUIDv8 string into a UUIDv8 object.
@@ -246,15 +452,38 @@ func (u *UUIDv8) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &uuidStr); err != nil {
 		return fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
+	return u.UnmarshalText([]byte(uuidStr))
+}
 
-	// Ensure the UUID string is valid and represents a UUIDv8
-	if !IsValidUUIDv8(uuidStr) {
-		return fmt.Errorf("input is not a valid UUIDv8: %s", uuidStr)
+// MarshalINI serializes a UUIDv8 object into its INI value representation.
+//
+// INI libraries such as gopkg.in/ini.v1 otherwise fall back to the struct representation,
+// which is unreadable and cannot be parsed back.
+//
+// Returns:
+// - The canonical UUID string.
+// - An error if the UUID is nil or its node is not 6 bytes.
+func (u *UUIDv8) MarshalINI() (string, error) {
+	if u == nil {
+		return "", errors.New("cannot marshal a nil UUIDv8")
+	}
+	if len(u.Node) != 6 {
+		return "", fmt.Errorf("invalid UUIDv8: node length must be 6 bytes, got %d bytes", len(u.Node))
 	}
+	return ToString(u), nil
+}
 
-	parsed, err := FromString(uuidStr)
+// UnmarshalINI deserializes an INI value into a UUIDv8 object.
+//
+// Parameters:
+// - s: The INI value holding the UUID string. Surrounding whitespace is ignored.
+//
+// Returns:
+// - An error if the value cannot be parsed as a UUID.
+func (u *UUIDv8) UnmarshalINI(s string) error {
+	parsed, err := FromString(strings.TrimSpace(s))
 	if err != nil {
-		return fmt.Errorf("failed to parse UUID string: %w", err)
+		return err
 	}
 
 	*u = *parsed
@@ -289,6 +518,30 @@ func (u *UUIDv8) Scan(value interface{}) error {
 		}
 		*u = *parsed
 		return nil
+	case sql.RawBytes:
+		// The driver reuses the buffer after Scan returns; the string conversion copies it before parsing
+		parsed, err := FromString(string(v))
+		if err != nil {
+			return err
+		}
+		*u = *parsed
+		return nil
 	}
 	return errors.New("unsupported type for UUIDv8")
 }
+
+// ScanRow scans a single UUIDv8 column from a [sql.Row].
+//
+// Parameters:
+// - row: The row returned by QueryRow, selecting exactly one UUID column.
+//
+// Returns:
+// - A pointer to the scanned UUIDv8 struct.
+// - An error if the query failed, returned no rows ([sql.ErrNoRows]), or the value cannot be scanned.
+func ScanRow(row *sql.Row) (*UUIDv8, error) {
+	var u UUIDv8
+	if err := row.Scan(&u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
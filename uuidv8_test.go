@@ -1,7 +1,16 @@
 package uuidv8_test
 
 import (
+	"bytes"
+	"database/sql"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -549,6 +558,291 @@ func TestUnmarshalJSON_ErrorCases(t *testing.T) {
 	}
 }
 
+func TestUUIDv8_Text(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+
+	var uuid uuidv8.UUIDv8
+	if err := uuid.UnmarshalText([]byte(uuidStr)); err != nil {
+		t.Fatalf("UnmarshalText failed: %v", err)
+	}
+
+	text, err := uuid.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if string(text) != uuidv8.ToString(&uuid) || string(text) != uuidStr {
+		t.Errorf("Expected %s, got %s", uuidStr, text)
+	}
+
+	// encoding/xml relies on encoding.TextMarshaler
+	type item struct {
+		ID   *uuidv8.UUIDv8 `xml:"id"`
+		Attr *uuidv8.UUIDv8 `xml:"ref,attr"`
+	}
+	data, err := xml.Marshal(item{ID: &uuid, Attr: &uuid})
+	if err != nil {
+		t.Fatalf("xml.Marshal failed: %v", err)
+	}
+	expected := `<item ref="` + uuidStr + `"><id>` + uuidStr + `</id></item>`
+	if string(data) != expected {
+		t.Errorf("Expected XML %s, got %s", expected, data)
+	}
+
+	var decoded item
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("xml.Unmarshal failed: %v", err)
+	}
+	if uuidv8.ToString(decoded.ID) != uuidStr || uuidv8.ToString(decoded.Attr) != uuidStr {
+		t.Errorf("XML round-trip mismatch: got %+v", decoded)
+	}
+}
+
+func TestUUIDv8_Text_ErrorCases(t *testing.T) {
+	invalidUUID := &uuidv8.UUIDv8{Timestamp: 123, ClockSeq: 0x1000, Node: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}}
+	if _, err := invalidUUID.MarshalText(); err == nil {
+		t.Error("Expected error for invalid UUID in MarshalText")
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if _, err := nilUUID.MarshalText(); err == nil {
+		t.Error("Expected error for nil UUID in MarshalText")
+	}
+
+	for _, input := range []string{"", "not-a-uuid", "0193bde4-a9fa-77eb-a304-6cf8530ece78", "00000000-0000-0000-0000-000000000000"} {
+		var uuid uuidv8.UUIDv8
+		if err := uuid.UnmarshalText([]byte(input)); err == nil {
+			t.Errorf("Expected error for input %q", input)
+		}
+	}
+}
+
+func TestFromStringWithBits(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	tests := []struct {
+		timestamp     uint64
+		timestampBits int
+		expected      uint64
+		description   string
+	}{
+		{0x12345678, uuidv8.TimestampBits32, 0x12345678, "32-bit timestamp"},
+		{0xFFFFFFFF, uuidv8.TimestampBits32, 0xFFFFFFFF, "Maximum 32-bit timestamp"},
+		{0x123456789ABC, uuidv8.TimestampBits48, 0x123456789ABC, "48-bit timestamp"},
+		{0x123456789ABC000, uuidv8.TimestampBits60, 0x123456789ABC000, "60-bit timestamp with zero low bits"},
+		{0x123456789ABCDEF, uuidv8.TimestampBits60, 0x123456789ABC000, "60-bit timestamp loses low 12 bits"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithParams(test.timestamp, 0x0123, node, test.timestampBits)
+			if err != nil {
+				t.Fatalf("NewWithParams failed: %v", err)
+			}
+
+			parsed, err := uuidv8.FromStringWithBits(uuid, test.timestampBits)
+			if err != nil {
+				t.Fatalf("FromStringWithBits failed: %v", err)
+			}
+			if parsed.Timestamp != test.expected {
+				t.Errorf("Timestamp mismatch: expected %#x, got %#x", test.expected, parsed.Timestamp)
+			}
+			if parsed.ClockSeq&0x0F3F != 0x0123 {
+				t.Errorf("ClockSeq mismatch: expected %#x, got %#x", 0x0123, parsed.ClockSeq)
+			}
+			if !bytes.Equal(parsed.Node, node) {
+				t.Errorf("Node mismatch: expected %x, got %x", node, parsed.Node)
+			}
+		})
+	}
+
+	// FromString decodes a 32-bit timestamp as 48 bits
+	uuid, _ := uuidv8.NewWithParams(0x12345678, 0, node, uuidv8.TimestampBits32)
+	if parsed, _ := uuidv8.FromString(uuid); parsed.Timestamp != 0x12345678<<16 {
+		t.Errorf("Expected FromString to return the shifted timestamp, got %#x", parsed.Timestamp)
+	}
+}
+
+func TestFromStringWithBits_InvalidInputs(t *testing.T) {
+	if _, err := uuidv8.FromStringWithBits("invalid-uuid", uuidv8.TimestampBits48); err == nil {
+		t.Error("Expected error for invalid UUID")
+	}
+	if _, err := uuidv8.FromStringWithBits("9a3d4049-0e2c-8080-0102-030405060000", 64); err == nil {
+		t.Error("Expected error for unsupported timestamp bit size")
+	}
+}
+
+func TestToStringUpper(t *testing.T) {
+	const lower = "9a3d4049-0e2c-8080-0102-030405060000"
+	const upper = "9A3D4049-0E2C-8080-0102-030405060000"
+
+	uuid, err := uuidv8.FromString(lower)
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	if got := uuidv8.ToStringUpper(uuid); got != upper {
+		t.Errorf("Expected %s, got %s", upper, got)
+	}
+	if got := uuidv8.ToString(uuid); got != lower {
+		t.Errorf("Expected %s, got %s", lower, got)
+	}
+
+	for _, input := range []string{upper, "9a3D4049-0E2c-8080-0102-030405060000"} {
+		if !uuidv8.IsValidUUIDv8(input) {
+			t.Errorf("IsValidUUIDv8 should accept %s", input)
+		}
+
+		parsed, err := uuidv8.FromString(input)
+		if err != nil {
+			t.Fatalf("FromString(%s) failed: %v", input, err)
+		}
+		if got := uuidv8.ToString(parsed); got != lower {
+			t.Errorf("Expected %s to parse as %s, got %s", input, lower, got)
+		}
+	}
+}
+
+func TestUUIDv8_String(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+	uuid, err := uuidv8.FromString(uuidStr)
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%s", uuidStr},
+		{"%v", uuidStr},
+		{"%q", `"` + uuidStr + `"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			if got := fmt.Sprintf(test.format, uuid); got != test.expected {
+				t.Errorf("Sprintf(%s) = %s, expected %s", test.format, got, test.expected)
+			}
+		})
+	}
+
+	if got := uuid.String(); got != uuidv8.ToString(uuid) {
+		t.Errorf("Expected %s, got %s", uuidv8.ToString(uuid), got)
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if got := nilUUID.String(); got != "" {
+		t.Errorf("Expected empty string for nil UUIDv8, got %s", got)
+	}
+	if got := fmt.Sprintf("%s", nilUUID); got != "" {
+		t.Errorf("Expected empty string when formatting nil UUIDv8, got %s", got)
+	}
+}
+
+func TestUUIDv8_FlagValue(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+
+	var uuid uuidv8.UUIDv8
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&uuid, "correlation-id", "UUID to attach to all requests")
+
+	if err := fs.Parse([]string{"-correlation-id", strings.ToUpper(uuidStr)}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := uuid.String(); got != uuidStr {
+		t.Errorf("Expected %s, got %s", uuidStr, got)
+	}
+	if got := fs.Lookup("correlation-id").Value.String(); got != uuidStr {
+		t.Errorf("Expected flag value %s, got %s", uuidStr, got)
+	}
+}
+
+func TestUUIDv8_FlagValue_Invalid(t *testing.T) {
+	for _, input := range []string{"", "not-a-uuid", "0193bde4-a9fa-77eb-a304-6cf8530ece78", "00000000-0000-0000-0000-000000000000"} {
+		var uuid uuidv8.UUIDv8
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		fs.Var(&uuid, "id", "")
+
+		err := fs.Parse([]string{"-id", input})
+		if err == nil {
+			t.Errorf("Expected error for input %q", input)
+		} else if !strings.Contains(err.Error(), "invalid UUIDv8 value") {
+			t.Errorf("Expected a descriptive error for input %q, got %v", input, err)
+		}
+	}
+}
+
+func TestUUIDv8_Binary(t *testing.T) {
+	const uuidStr = "9a3d4049-0e2c-8080-0102-030405060000"
+	uuid, err := uuidv8.FromString(uuidStr)
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+
+	data, err := uuid.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	expected := uuidv8.ToByteArray(uuid)
+	if !bytes.Equal(data, expected[:]) {
+		t.Errorf("Expected %x, got %x", expected, data)
+	}
+
+	var parsed uuidv8.UUIDv8
+	if err := parsed.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if result := uuidv8.ToString(&parsed); result != uuidStr {
+		t.Errorf("Round-trip mismatch: expected %s, got %s", uuidStr, result)
+	}
+
+	// encoding/gob relies on encoding.BinaryMarshaler
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(uuid); err != nil {
+		t.Fatalf("gob Encode failed: %v", err)
+	}
+	var decoded uuidv8.UUIDv8
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("gob Decode failed: %v", err)
+	}
+	if result := uuidv8.ToString(&decoded); result != uuidStr {
+		t.Errorf("gob round-trip mismatch: expected %s, got %s", uuidStr, result)
+	}
+}
+
+func TestUUIDv8_Binary_ErrorCases(t *testing.T) {
+	var nilUUID *uuidv8.UUIDv8
+	if _, err := nilUUID.MarshalBinary(); err == nil {
+		t.Error("Expected error for nil UUID in MarshalBinary")
+	}
+
+	invalidNode := &uuidv8.UUIDv8{Timestamp: 1, Node: []byte{0x01, 0x02}}
+	if _, err := invalidNode.MarshalBinary(); err == nil {
+		t.Error("Expected error for invalid node length in MarshalBinary")
+	}
+
+	tests := []struct {
+		input       []byte
+		description string
+	}{
+		{nil, "Nil slice"},
+		{make([]byte, 15), "Too short"},
+		{make([]byte, 17), "Too long"},
+		{make([]byte, 16), "All-zero UUID"},
+		{[]byte{0x9a, 0x3d, 0x40, 0x49, 0x0e, 0x2c, 0x40, 0x80, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0, 0}, "Incorrect version"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			var uuid uuidv8.UUIDv8
+			if err := uuid.UnmarshalBinary(test.input); err == nil {
+				t.Errorf("Expected error for input %x", test.input)
+			}
+		})
+	}
+}
+
 func TestNewWithParams_MaxValues(t *testing.T) {
 	node := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
 	timestamp := uint64(1<<60 - 1)
@@ -792,3 +1086,270 @@ func TestIsValidUUIDv8_InvalidUUIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromNamespace(t *testing.T) {
+	namespace := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	name := []byte("customer@example.com")
+
+	for _, bits := range []int{uuidv8.TimestampBits32, uuidv8.TimestampBits48, uuidv8.TimestampBits60} {
+		first, err := uuidv8.NewFromNamespace(namespace, name, bits)
+		if err != nil {
+			t.Fatalf("NewFromNamespace failed for %d bits: %v", bits, err)
+		}
+		if !uuidv8.IsValidUUIDv8(first) {
+			t.Errorf("NewFromNamespace generated an invalid UUIDv8: %s", first)
+		}
+
+		second, _ := uuidv8.NewFromNamespace(namespace, name, bits)
+		if first != second {
+			t.Errorf("Expected the same UUID for the same inputs: %s, %s", first, second)
+		}
+
+		other, _ := uuidv8.NewFromNamespace(namespace, []byte("other@example.com"), bits)
+		if first == other {
+			t.Errorf("Expected different UUIDs for different names: %s", first)
+		}
+
+		otherNamespace := namespace
+		otherNamespace[0] ^= 0xFF
+		if otherNS, _ := uuidv8.NewFromNamespace(otherNamespace, name, bits); first == otherNS {
+			t.Errorf("Expected different UUIDs for different namespaces: %s", first)
+		}
+	}
+
+	// The layout is fixed: changing it would change every derived ID
+	uuid, err := uuidv8.NewFromNamespace([16]byte{}, []byte("name"), uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("NewFromNamespace failed: %v", err)
+	}
+	if expected := "19cb8a66-55ba-8bb4-a53f-99a9c5950000"; uuid != expected {
+		t.Errorf("Expected %s, got %s", expected, uuid)
+	}
+}
+
+func TestNewFromNamespace_InvalidBits(t *testing.T) {
+	if _, err := uuidv8.NewFromNamespace([16]byte{}, []byte("name"), 64); err == nil {
+		t.Error("Expected error for unsupported timestamp bit size")
+	}
+}
+
+func TestFromString_AlternativeFormats(t *testing.T) {
+	expected := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	tests := []struct {
+		input       string
+		description string
+	}{
+		{"9a3d40490e2c80800102030405060000", "Compact"},
+		{"9A3D4049-0E2C-8080-0102-030405060000", "Uppercase"},
+		{"{9a3d4049-0e2c-8080-0102-030405060000}", "Braced"},
+		{"urn:uuid:9a3d4049-0e2c-8080-0102-030405060000", "URN"},
+		{"URN:UUID:9a3d4049-0e2c-8080-0102-030405060000", "Uppercase URN prefix"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			parsed, err := uuidv8.FromString(test.input)
+			if err != nil {
+				t.Fatalf("FromString failed for %s: %v", test.input, err)
+			}
+			if result := uuidv8.ToString(parsed); result != expected {
+				t.Errorf("Expected %s, got %s", expected, result)
+			}
+			if !uuidv8.IsValidUUIDv8(test.input) {
+				t.Errorf("Expected %s to be a valid UUIDv8", test.input)
+			}
+		})
+	}
+}
+
+func TestFromString_InvalidAlternativeFormats(t *testing.T) {
+	tests := []string{
+		"(9a3d4049-0e2c-8080-0102-030405060000)",        // Wrong brackets
+		"{9a3d4049-0e2c-8080-0102-030405060000",         // Missing closing brace
+		"{9a3d40490e2c-8080-0102-0304050600000}",        // Misplaced dash inside braces
+		"uri:uuid:9a3d4049-0e2c-8080-0102-030405060000", // Wrong URN prefix
+		"urn:uuid:9a3d4049-0e2c-8080-0102-03040506000g", // Invalid character in URN
+	}
+
+	for _, input := range tests {
+		t.Run("Testing UUID: "+input, func(t *testing.T) {
+			if _, err := uuidv8.FromString(input); err == nil {
+				t.Errorf("Expected error, got nil for input: %s", input)
+			}
+		})
+	}
+}
+
+func TestNewWithCustomFields(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	timestamp := uint64(1633024800000000000)
+
+	t.Run("Explicit fields match NewWithParams", func(t *testing.T) {
+		uuid, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{
+			Timestamp:     timestamp,
+			ClockSeq:      0x0123,
+			Node:          node,
+			TimestampBits: uuidv8.TimestampBits32,
+		})
+		if err != nil {
+			t.Fatalf("NewWithCustomFields failed: %v", err)
+		}
+
+		expected, _ := uuidv8.NewWithParams(timestamp, 0x0123, node, uuidv8.TimestampBits32)
+		if uuid != expected {
+			t.Errorf("Expected %s, got %s", expected, uuid)
+		}
+	})
+
+	t.Run("Zero values use defaults", func(t *testing.T) {
+		before := uint64(time.Now().UnixNano()) & (1<<48 - 1)
+		uuid, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{})
+		if err != nil {
+			t.Fatalf("NewWithCustomFields failed: %v", err)
+		}
+
+		if !uuidv8.IsValidUUIDv8(uuid) {
+			t.Errorf("NewWithCustomFields generated an invalid UUIDv8: %s", uuid)
+		}
+
+		parsed, _ := uuidv8.FromString(uuid)
+		if parsed.Timestamp < before {
+			t.Errorf("Expected current timestamp, got %d (before %d)", parsed.Timestamp, before)
+		}
+	})
+
+	t.Run("NodeFunc supplies the node", func(t *testing.T) {
+		uuid, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{
+			Timestamp: timestamp,
+			NodeFunc:  func() ([]byte, error) { return node, nil },
+		})
+		if err != nil {
+			t.Fatalf("NewWithCustomFields failed: %v", err)
+		}
+
+		parsed, _ := uuidv8.FromString(uuid)
+		for i := range node {
+			if parsed.Node[i] != node[i] {
+				t.Fatalf("Node mismatch: expected %x, got %x", node, parsed.Node)
+			}
+		}
+	})
+
+	t.Run("Errors are propagated", func(t *testing.T) {
+		if _, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{
+			NodeFunc: func() ([]byte, error) { return nil, errors.New("no node available") },
+		}); err == nil {
+			t.Error("Expected error from NodeFunc")
+		}
+		if _, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{Node: []byte{0x01}}); err == nil {
+			t.Error("Expected error for invalid node length")
+		}
+		if _, err := uuidv8.NewWithCustomFields(uuidv8.CustomFields{TimestampBits: 100}); err == nil {
+			t.Error("Expected error for invalid timestamp bit size")
+		}
+	})
+}
+
+func TestUUIDv8_Scan_RawBytes(t *testing.T) {
+	raw := sql.RawBytes("9a3d4049-0e2c-8080-0102-030405060000")
+
+	var uuid uuidv8.UUIDv8
+	if err := uuid.Scan(raw); err != nil {
+		t.Fatalf("Failed to scan sql.RawBytes: %v", err)
+	}
+
+	// Simulate the driver reusing its buffer
+	copy(raw, "ffffffff-ffff-8fff-ffff-ffffffffffff")
+
+	if result := uuidv8.ToString(&uuid); result != "9a3d4049-0e2c-8080-0102-030405060000" {
+		t.Errorf("Scanned UUIDv8 changed after buffer reuse: %s", result)
+	}
+
+	if err := uuid.Scan(sql.RawBytes("invalid-uuid")); err == nil {
+		t.Error("Expected error for invalid sql.RawBytes")
+	}
+}
+
+func TestScanRow(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	uuidStr := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	mock.ExpectQuery("SELECT uuid FROM items").
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow(uuidStr))
+	mock.ExpectQuery("SELECT uuid FROM items").
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}))
+	mock.ExpectQuery("SELECT uuid FROM items").
+		WillReturnRows(sqlmock.NewRows([]string{"uuid"}).AddRow("invalid-uuid"))
+
+	uuid, err := uuidv8.ScanRow(db.QueryRow("SELECT uuid FROM items"))
+	if err != nil {
+		t.Fatalf("ScanRow failed: %v", err)
+	}
+	if result := uuidv8.ToString(uuid); result != uuidStr {
+		t.Errorf("Expected UUIDv8 %s, got %s", uuidStr, result)
+	}
+
+	if _, err := uuidv8.ScanRow(db.QueryRow("SELECT uuid FROM items")); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Expected sql.ErrNoRows, got %v", err)
+	}
+
+	if _, err := uuidv8.ScanRow(db.QueryRow("SELECT uuid FROM items")); err == nil {
+		t.Error("Expected error for invalid UUID value")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestUUIDv8_INI(t *testing.T) {
+	document := `
+[service]
+name = billing
+instance_id = 9a3d4049-0e2c-8080-0102-030405060000
+`
+
+	// Minimal in-memory INI parsing: collect key/value pairs of the document
+	values := map[string]string{}
+	for _, line := range strings.Split(document, "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok {
+			values[strings.TrimSpace(key)] = value
+		}
+	}
+
+	var uuid uuidv8.UUIDv8
+	if err := uuid.UnmarshalINI(values["instance_id"]); err != nil {
+		t.Fatalf("UnmarshalINI failed: %v", err)
+	}
+
+	value, err := uuid.MarshalINI()
+	if err != nil {
+		t.Fatalf("MarshalINI failed: %v", err)
+	}
+	if value != "9a3d4049-0e2c-8080-0102-030405060000" {
+		t.Errorf("Expected INI value %s, got %s", "9a3d4049-0e2c-8080-0102-030405060000", value)
+	}
+}
+
+func TestUUIDv8_INI_ErrorCases(t *testing.T) {
+	var uuid uuidv8.UUIDv8
+	if err := uuid.UnmarshalINI("invalid-uuid"); err == nil {
+		t.Error("Expected error for invalid INI value")
+	}
+
+	var nilUUID *uuidv8.UUIDv8
+	if _, err := nilUUID.MarshalINI(); err == nil {
+		t.Error("Expected error when marshalling a nil UUIDv8")
+	}
+
+	invalid := &uuidv8.UUIDv8{Timestamp: 1, Node: []byte{0x01, 0x02}}
+	if _, err := invalid.MarshalINI(); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+}
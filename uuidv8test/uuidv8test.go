@@ -0,0 +1,59 @@
+// Package uuidv8test provides helpers for tests that use UUIDv8s.
+//
+// The helpers fail the test instead of returning errors. They live in a separate package so the
+// testing package is only linked into binaries that import uuidv8test.
+package uuidv8test
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+// NewWithTestClock generates a UUIDv8 with a fixed timestamp for use in tests.
+//
+// The clock sequence and node are random. Instead of returning an error, the test is failed
+// immediately via t.Fatalf.
+//
+// Parameters:
+// - t: The test or benchmark using the UUID.
+// - nanoseconds: The fixed timestamp, encoded with TimestampBits48.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+func NewWithTestClock(t testing.TB, nanoseconds uint64) string {
+	t.Helper()
+
+	// 6 bytes of node followed by 2 bytes of clock sequence
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatalf("uuidv8test: failed to generate random data: %v", err)
+	}
+	clockSeq := binary.BigEndian.Uint16(random[6:]) & 0x0FFF
+
+	uuid, err := uuidv8.NewWithParams(nanoseconds, clockSeq, random[:6], uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("uuidv8test: failed to generate UUIDv8 with test clock: %v", err)
+	}
+	return uuid
+}
+
+// MustFromStringT parses a UUIDv8 string and fails the test if parsing fails.
+//
+// Parameters:
+// - t: The test or benchmark using the UUID.
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - A pointer to a UUIDv8 struct containing the parsed components.
+func MustFromStringT(t testing.TB, uuid string) *uuidv8.UUIDv8 {
+	t.Helper()
+
+	parsed, err := uuidv8.FromString(uuid)
+	if err != nil {
+		t.Fatalf("uuidv8test: failed to parse UUIDv8 %q: %v", uuid, err)
+	}
+	return parsed
+}
@@ -0,0 +1,55 @@
+package uuidv8test_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+	"github.com/ash3in/uuidv8/uuidv8test"
+)
+
+// fatalRecorder records t.Fatalf calls instead of stopping the test.
+type fatalRecorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(string, ...interface{}) {
+	r.failed = true
+}
+
+func TestNewWithTestClock(t *testing.T) {
+	timestamp := uint64(1633024800000000000)
+
+	first := uuidv8test.NewWithTestClock(t, timestamp)
+	second := uuidv8test.NewWithTestClock(t, timestamp)
+
+	if !uuidv8.IsValidUUIDv8(first) {
+		t.Errorf("NewWithTestClock generated an invalid UUIDv8: %s", first)
+	}
+	if first == second {
+		t.Errorf("Expected random clock sequence and node, got identical UUIDs: %s", first)
+	}
+
+	parsed := uuidv8test.MustFromStringT(t, first)
+	if expected := timestamp & (1<<48 - 1); parsed.Timestamp != expected {
+		t.Errorf("Timestamp mismatch: expected %d, got %d", expected, parsed.Timestamp)
+	}
+	if first[:13] != second[:13] {
+		t.Errorf("Expected identical timestamps, got %s and %s", first, second)
+	}
+}
+
+func TestMustFromStringT(t *testing.T) {
+	uuidStr := "9a3d4049-0e2c-8080-0102-030405060000"
+
+	if result := uuidv8.ToString(uuidv8test.MustFromStringT(t, uuidStr)); result != uuidStr {
+		t.Errorf("Expected %s, got %s", uuidStr, result)
+	}
+
+	recorder := &fatalRecorder{TB: t}
+	if parsed := uuidv8test.MustFromStringT(recorder, "invalid-uuid"); parsed != nil || !recorder.failed {
+		t.Errorf("Expected MustFromStringT to fail the test for an invalid UUID")
+	}
+}
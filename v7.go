@@ -0,0 +1,50 @@
+package uuidv8
+
+import "time"
+
+// maxV7ClockSkew is how far into the future a millisecond timestamp may lie and still be
+// considered compatible with UUIDv7 ordering, to tolerate clock skew between hosts.
+const maxV7ClockSkew = time.Hour
+
+// NewWithEpochV7 generates a UUIDv8 using the UUIDv7 timestamp convention.
+//
+// The top 48 bits hold the number of milliseconds since the Unix epoch, exactly like UUIDv7,
+// while the version and variant bits still mark the UUID as a UUIDv8. A UUIDv7 and a UUIDv8
+// generated in the same millisecond therefore sort next to each other, which makes this a
+// migration aid for systems moving between the two versions without breaking index order.
+//
+// Parameters:
+// - node: A 6-byte slice representing a unique identifier.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the node is invalid or the random clock sequence cannot be generated.
+func NewWithEpochV7(node []byte) (string, error) {
+	clockSeq, err := randomClockSeq()
+	if err != nil {
+		return "", err
+	}
+
+	return NewWithParams(uint64(time.Now().UnixMilli()), clockSeq, node, TimestampBits48)
+}
+
+// CompatibleWithV7Order reports whether a UUIDv8 sorts consistently with UUIDv7s.
+//
+// The check passes when the UUID is a valid UUIDv8 whose 48-bit timestamp is a plausible
+// millisecond Unix timestamp, i.e. one that does not lie in the future (allowing for a small
+// clock skew). UUIDs generated by New, which stores nanoseconds, will generally fail this check.
+//
+// Parameters:
+// - v8uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - A boolean indicating whether the UUID follows the UUIDv7 millisecond epoch convention.
+func CompatibleWithV7Order(v8uuid string) bool {
+	uuidBytes, err := parseUUIDv8(v8uuid)
+	if err != nil {
+		return false
+	}
+
+	maxMillis := uint64(time.Now().Add(maxV7ClockSkew).UnixMilli())
+	return decodeTimestamp(uuidBytes[:6]) <= maxMillis
+}
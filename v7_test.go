@@ -0,0 +1,78 @@
+package uuidv8_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithEpochV7(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	before := uint64(time.Now().UnixMilli())
+	uuid, err := uuidv8.NewWithEpochV7(node)
+	if err != nil {
+		t.Fatalf("NewWithEpochV7 failed: %v", err)
+	}
+	after := uint64(time.Now().UnixMilli())
+
+	if !uuidv8.IsValidUUIDv8(uuid) {
+		t.Errorf("NewWithEpochV7 generated an invalid UUIDv8: %s", uuid)
+	}
+
+	parsed, _ := uuidv8.FromString(uuid)
+	if parsed.Timestamp < before || parsed.Timestamp > after {
+		t.Errorf("Timestamp %d outside of expected millisecond range [%d, %d]", parsed.Timestamp, before, after)
+	}
+
+	if !uuidv8.CompatibleWithV7Order(uuid) {
+		t.Errorf("Expected UUID %s to be compatible with UUIDv7 order", uuid)
+	}
+
+	if _, err := uuidv8.NewWithEpochV7([]byte{0x01, 0x02}); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+}
+
+func TestNewWithEpochV7_SortsWithV7(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	// UUIDv7 layout: 48-bit millisecond timestamp followed by the version nibble
+	v7 := "0193bde4-a9fa-77eb-a304-6cf8530ece78"
+	v8, err := uuidv8.NewWithEpochV7(node)
+	if err != nil {
+		t.Fatalf("NewWithEpochV7 failed: %v", err)
+	}
+
+	if v7 >= v8 {
+		t.Errorf("Expected older UUIDv7 %s to sort before UUIDv8 %s", v7, v8)
+	}
+}
+
+func TestCompatibleWithV7Order(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	future, err := uuidv8.NewWithParams(1<<48-1, 0, node, uuidv8.TimestampBits48)
+	if err != nil {
+		t.Fatalf("NewWithParams failed: %v", err)
+	}
+
+	tests := []struct {
+		uuid        string
+		expected    bool
+		description string
+	}{
+		{future, false, "Timestamp far in the future"},
+		{"invalid-uuid", false, "Invalid UUID format"},
+		{"0193bde4-a9fa-77eb-a304-6cf8530ece78", false, "UUIDv7 is not a UUIDv8"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if result := uuidv8.CompatibleWithV7Order(test.uuid); result != test.expected {
+				t.Errorf("Expected %v for %s, got %v", test.expected, test.uuid, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package uuidv8
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Validate, and wrapped by the parsing functions of this package.
+//
+// Use errors.Is to tell them apart, e.g. to report the reason of a rejected UUID to a client.
+var (
+	ErrInvalidLength = errors.New("invalid UUID length")                           // The input has none of the accepted lengths.
+	ErrInvalidFormat = errors.New("invalid UUID format")                           // Non-hex characters, misplaced dashes, braces or URN prefix.
+	ErrWrongVersion  = errors.New("UUID is not version 8")                         // The version nibble is not 8.
+	ErrWrongVariant  = errors.New("UUID does not carry the expected variant bits") // The variant bits are not RFC4122.
+	ErrNilUUID       = errors.New("all-zero UUID is not a valid UUIDv8")           // The input is the nil UUID.
+)
+
+// Validate checks whether a string is a valid UUIDv8 and reports why it is not.
+//
+// Parameters:
+// - uuid: A string representation of a UUID.
+//
+// Returns:
+// - Nil if the UUID is a well-formed UUIDv8 with RFC4122 variant bits.
+// - An error wrapping ErrInvalidLength, ErrInvalidFormat, ErrWrongVersion, ErrWrongVariant or ErrNilUUID otherwise.
+func Validate(uuid string) error {
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return err
+	}
+	return validateUUIDv8Bytes(uuidBytes, variantRFC4122)
+}
+
+// ValidateAndRepair checks a UUID and fixes its version and variant bits if they are the only problem.
+//
+// Import pipelines sometimes receive UUIDs with a correct UUIDv8 structure but a wrong version
+// tag (e.g. produced by a generator that stamped version 7). Such UUIDs are repaired by setting
+// the version to 8 and the variant to RFC4122; every other bit is preserved.
+//
+// Parameters:
+// - uuid: A string representation of a UUID.
+//
+// Returns:
+// - repaired: The repaired UUID in canonical form, or the input unchanged if it was already valid.
+// - wasRepaired: Whether the version or variant bits had to be fixed.
+// - err: An error if the UUID is malformed (wrong length, invalid hex, misplaced dashes) or all zero.
+func ValidateAndRepair(uuid string) (repaired string, wasRepaired bool, err error) {
+	uuidBytes, err := parseUUID(uuid)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse UUID: %w", err)
+	}
+	if isAllZeroUUID(uuidBytes) {
+		return "", false, errors.New("all-zero UUID cannot be repaired")
+	}
+
+	version := uuidBytes[6] >> 4
+	variant := (uuidBytes[7] >> 6) & 0x03
+	if version == versionV8 && variant == variantRFC4122 {
+		return uuid, false, nil
+	}
+
+	uuidBytes[6] = (byte(versionV8) << 4) | (uuidBytes[6] & 0x0F)
+	uuidBytes[7] = (variantRFC4122 << 6) | (uuidBytes[7] & 0x3F)
+
+	return formatUUID(uuidBytes, false), true, nil
+}
@@ -0,0 +1,102 @@
+package uuidv8_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    error
+		description string
+	}{
+		{"9a3d4049-0e2c-8080-0102-030405060000", nil, "Valid UUIDv8"},
+		{"{9A3D4049-0E2C-8080-0102-030405060000}", nil, "Valid braced uppercase UUIDv8"},
+		{"", uuidv8.ErrInvalidLength, "Empty string"},
+		{"9a3d4049-0e2c-8080-0102-0304050600", uuidv8.ErrInvalidLength, "Too short"},
+		{"9a3d4049-0e2c-8080-0102-03040506000g", uuidv8.ErrInvalidFormat, "Non-hex character"},
+		{"9a3d40490-e2c-8080-0102-030405060000", uuidv8.ErrInvalidFormat, "Misplaced dash"},
+		{"(9a3d4049-0e2c-8080-0102-030405060000)", uuidv8.ErrInvalidFormat, "Wrong braces"},
+		{"urn:uuud:9a3d4049-0e2c-8080-0102-030405060000", uuidv8.ErrInvalidFormat, "Wrong URN prefix"},
+		{"0193bde4-a9fa-77eb-a304-6cf8530ece78", uuidv8.ErrWrongVersion, "UUIDv7"},
+		{"9a3d4049-0e2c-80c0-0102-030405060000", uuidv8.ErrWrongVariant, "Microsoft variant"},
+		{"00000000-0000-0000-0000-000000000000", uuidv8.ErrNilUUID, "All-zero UUID"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := uuidv8.Validate(test.input)
+			if !errors.Is(err, test.expected) || (test.expected == nil && err != nil) {
+				t.Errorf("Validate(%q) = %v, expected %v", test.input, err, test.expected)
+			}
+			if valid := uuidv8.IsValidUUIDv8(test.input); valid != (test.expected == nil) {
+				t.Errorf("IsValidUUIDv8(%q) = %v, inconsistent with Validate", test.input, valid)
+			}
+		})
+	}
+
+	// The actual version is part of the message
+	if err := uuidv8.Validate("0193bde4-a9fa-77eb-a304-6cf8530ece78"); err == nil || !strings.Contains(err.Error(), "version 7") {
+		t.Errorf("Expected error mentioning version 7, got %v", err)
+	}
+
+	// Parsing functions wrap the same errors
+	if _, err := uuidv8.FromString("invalid-uuid"); !errors.Is(err, uuidv8.ErrInvalidLength) {
+		t.Errorf("Expected FromString to wrap ErrInvalidLength, got %v", err)
+	}
+}
+
+func TestValidateAndRepair(t *testing.T) {
+	tests := []struct {
+		input            string
+		expected         string
+		expectedRepaired bool
+		description      string
+	}{
+		{"9a3d4049-0e2c-8080-0102-030405060000", "9a3d4049-0e2c-8080-0102-030405060000", false, "Valid UUIDv8"},
+		{"9a3d4049-0e2c-7080-0102-030405060000", "9a3d4049-0e2c-8080-0102-030405060000", true, "Wrong version"},
+		{"9a3d4049-0e2c-80c0-0102-030405060000", "9a3d4049-0e2c-8080-0102-030405060000", true, "Wrong variant"},
+		{"9a3d4049-0e2c-4f3f-0102-030405060000", "9a3d4049-0e2c-8fbf-0102-030405060000", true, "Wrong version and variant"},
+		{"9A3D40490E2C7080010203040506AAAA", "9a3d4049-0e2c-8080-0102-03040506aaaa", true, "Compact uppercase input"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			repaired, wasRepaired, err := uuidv8.ValidateAndRepair(test.input)
+			if err != nil {
+				t.Fatalf("ValidateAndRepair failed: %v", err)
+			}
+			if wasRepaired != test.expectedRepaired {
+				t.Errorf("Expected wasRepaired=%v, got %v", test.expectedRepaired, wasRepaired)
+			}
+			if repaired != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, repaired)
+			}
+			if !uuidv8.IsValidUUIDv8(repaired) {
+				t.Errorf("Repaired UUID is not a valid UUIDv8: %s", repaired)
+			}
+		})
+	}
+}
+
+func TestValidateAndRepair_MalformedInputs(t *testing.T) {
+	malformed := []string{
+		"",
+		"123",                                   // Too short
+		"123e4567e89b12d3a45642661417400g",      // Invalid character
+		"123e4567-e89b-12d3-a456-42-6614174000", // Misplaced dash
+		"00000000-0000-0000-0000-000000000000",  // All-zero UUID
+	}
+
+	for _, input := range malformed {
+		t.Run("Malformed UUID "+input, func(t *testing.T) {
+			if _, _, err := uuidv8.ValidateAndRepair(input); err == nil {
+				t.Errorf("Expected error for malformed input %s", input)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package uuidv8
+
+import "fmt"
+
+// Variant bit values that may be passed to NewWithCustomVariant, IsValidUUIDv8WithVariant and WithVariant.
+const (
+	VariantNCS       = 0b00 // Reserved, NCS backward compatibility
+	VariantRFC4122   = 0b10 // The variant mandated for UUIDv8
+	VariantMicrosoft = 0b11 // Reserved, Microsoft Corporation backward compatibility
+)
+
+// NewWithCustomVariant generates a UUIDv8-layout UUID with arbitrary variant bits.
+//
+// UUIDv8 mandates the RFC4122 variant, so the result is only a valid UUIDv8 when variant is
+// VariantRFC4122. Other values are meant for legacy platforms that expect NCS or Microsoft
+// variant bits on otherwise UUIDv8-structured identifiers. The variant occupies the same two
+// bits that NewWithParams uses for the RFC4122 variant. The clock sequence is random.
+//
+// Parameters:
+// - variant: The 2-bit variant value (0–3).
+// - node: A 6-byte slice representing a unique identifier.
+// - timestamp: A 32-, 48-, or 60-bit timestamp value (depending on `bits`).
+// - bits: The number of bits in the timestamp (32, 48, or 60).
+//
+// Returns:
+// - A string representation of the generated UUID.
+// - An error if the variant, node, or timestamp size is invalid.
+func NewWithCustomVariant(variant byte, node []byte, timestamp uint64, bits int) (string, error) {
+	if variant > 0b11 {
+		return "", fmt.Errorf("variant must be between 0 and 3, got %d", variant)
+	}
+
+	clockSeq, err := randomClockSeq()
+	if err != nil {
+		return "", err
+	}
+
+	uuid, err := buildUUID(timestamp, clockSeq, node, bits, variant)
+	if err != nil {
+		return "", err
+	}
+
+	return formatUUID(uuid, false), nil
+}
+
+// IsValidUUIDv8WithVariant validates if a given string is a well-formed UUIDv8 carrying the given variant bits.
+//
+// Parameters:
+// - uuid: A string representation of a UUID.
+// - variant: The expected 2-bit variant value (0–3).
+//
+// Returns:
+// - A boolean indicating whether the UUID has the UUIDv8 version, the expected variant, and is not all zero.
+func IsValidUUIDv8WithVariant(uuid string, variant byte) bool {
+	_, err := parseUUIDWithVariant(uuid, variant)
+	return err == nil
+}
@@ -0,0 +1,70 @@
+package uuidv8_test
+
+import (
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithCustomVariant(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	timestamp := uint64(1633024800000000000)
+
+	variants := []struct {
+		variant     byte
+		description string
+	}{
+		{uuidv8.VariantNCS, "NCS variant"},
+		{0b01, "NCS variant with high bit set"},
+		{uuidv8.VariantRFC4122, "RFC4122 variant"},
+		{uuidv8.VariantMicrosoft, "Microsoft variant"},
+	}
+
+	for _, test := range variants {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithCustomVariant(test.variant, node, timestamp, uuidv8.TimestampBits48)
+			if err != nil {
+				t.Fatalf("NewWithCustomVariant failed: %v", err)
+			}
+
+			if !uuidv8.IsValidUUIDv8WithVariant(uuid, test.variant) {
+				t.Errorf("Expected UUID %s to carry variant %d", uuid, test.variant)
+			}
+
+			isRFC4122 := test.variant == uuidv8.VariantRFC4122
+			if uuidv8.IsValidUUIDv8(uuid) != isRFC4122 {
+				t.Errorf("IsValidUUIDv8 mismatch for UUID %s: expected %v", uuid, isRFC4122)
+			}
+		})
+	}
+}
+
+func TestNewWithCustomVariant_InvalidInputs(t *testing.T) {
+	node := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	if _, err := uuidv8.NewWithCustomVariant(4, node, 1633024800, uuidv8.TimestampBits48); err == nil {
+		t.Error("Expected error for out-of-range variant")
+	}
+	if _, err := uuidv8.NewWithCustomVariant(uuidv8.VariantNCS, []byte{0x01}, 1633024800, uuidv8.TimestampBits48); err == nil {
+		t.Error("Expected error for invalid node length")
+	}
+	if _, err := uuidv8.NewWithCustomVariant(uuidv8.VariantNCS, node, 1633024800, 100); err == nil {
+		t.Error("Expected error for invalid timestamp bit size")
+	}
+}
+
+func TestIsValidUUIDv8WithVariant_InvalidUUIDs(t *testing.T) {
+	invalidUUIDs := []string{
+		"invalid-uuid",
+		"00000000-0000-0000-0000-000000000000", // All-zero UUID
+		"9a3d4049-0e2c-7080-0102-030405060000", // Incorrect version
+	}
+
+	for _, uuid := range invalidUUIDs {
+		t.Run("Invalid UUID "+uuid, func(t *testing.T) {
+			if uuidv8.IsValidUUIDv8WithVariant(uuid, uuidv8.VariantRFC4122) {
+				t.Errorf("Expected UUID %s to be invalid", uuid)
+			}
+		})
+	}
+}
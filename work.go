@@ -0,0 +1,63 @@
+package uuidv8
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// NewWithWorkID generates a UUIDv8 identifying a task in a work-stealing queue by worker and task index.
+//
+// The node holds the worker ID in its first 2 bytes and the work ID in the last 4 bytes (both
+// big-endian), so all tasks originating from the same worker share a node prefix. The timestamp
+// is the current time in nanoseconds, which orders tasks within a worker's queue, and the clock
+// sequence is random.
+//
+// In the canonical string the worker ID is the 4 hex digits starting at offset 19, so queues can
+// filter tasks by worker with strings.HasPrefix(uuid[19:], fmt.Sprintf("%04x", workerID)).
+//
+// Parameters:
+// - workID: The index of the task within the worker's queue.
+// - workerID: The identifier of the worker that created the task.
+//
+// Returns:
+// - A string representation of the generated UUIDv8.
+// - An error if the random clock sequence cannot be generated.
+func NewWithWorkID(workID uint32, workerID uint16) (string, error) {
+	node := make([]byte, 6)
+	binary.BigEndian.PutUint16(node[:2], workerID)
+	binary.BigEndian.PutUint32(node[2:], workID)
+
+	return newWithNode(node)
+}
+
+// ExtractWorkerID returns the worker ID of a UUIDv8 generated by NewWithWorkID.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The worker ID stored in the first 2 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractWorkerID(uuid string) (uint16, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract worker ID: %w", err)
+	}
+	return binary.BigEndian.Uint16(node[:2]), nil
+}
+
+// ExtractWorkID returns the work ID of a UUIDv8 generated by NewWithWorkID.
+//
+// Parameters:
+// - uuid: A string representation of a UUIDv8.
+//
+// Returns:
+// - The work ID stored in the last 4 node bytes.
+// - An error if the input is not a valid UUIDv8.
+func ExtractWorkID(uuid string) (uint32, error) {
+	node, err := extractNode(uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract work ID: %w", err)
+	}
+	return binary.BigEndian.Uint32(node[2:]), nil
+}
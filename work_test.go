@@ -0,0 +1,63 @@
+package uuidv8_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ash3in/uuidv8"
+)
+
+func TestNewWithWorkID(t *testing.T) {
+	tests := []struct {
+		workID      uint32
+		workerID    uint16
+		description string
+	}{
+		{1, 1, "First task"},
+		{0x12345678, 0xABCD, "Arbitrary values"},
+		{0xFFFFFFFF, 0xFFFF, "Maximum values"},
+		{0, 0, "Zero values"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			uuid, err := uuidv8.NewWithWorkID(test.workID, test.workerID)
+			if err != nil {
+				t.Fatalf("NewWithWorkID failed: %v", err)
+			}
+			if !uuidv8.IsValidUUIDv8(uuid) {
+				t.Errorf("NewWithWorkID generated an invalid UUIDv8: %s", uuid)
+			}
+
+			workerID, err := uuidv8.ExtractWorkerID(uuid)
+			if err != nil {
+				t.Fatalf("ExtractWorkerID failed: %v", err)
+			}
+			if workerID != test.workerID {
+				t.Errorf("Worker ID mismatch: expected %d, got %d", test.workerID, workerID)
+			}
+
+			workID, err := uuidv8.ExtractWorkID(uuid)
+			if err != nil {
+				t.Fatalf("ExtractWorkID failed: %v", err)
+			}
+			if workID != test.workID {
+				t.Errorf("Work ID mismatch: expected %d, got %d", test.workID, workID)
+			}
+
+			if !strings.HasPrefix(uuid[19:], fmt.Sprintf("%04x", test.workerID)) {
+				t.Errorf("Expected node of %s to start with worker ID %04x", uuid, test.workerID)
+			}
+		})
+	}
+}
+
+func TestExtractWorkID_InvalidUUID(t *testing.T) {
+	if _, err := uuidv8.ExtractWorkerID("invalid-uuid"); err == nil {
+		t.Error("ExtractWorkerID: expected error for invalid UUID")
+	}
+	if _, err := uuidv8.ExtractWorkID("invalid-uuid"); err == nil {
+		t.Error("ExtractWorkID: expected error for invalid UUID")
+	}
+}